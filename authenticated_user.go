@@ -0,0 +1,54 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+)
+
+// Me gets authenticated user.
+func (c *Client) Me(ctx context.Context) (*AuthenticatedUser, error) {
+	u := &AuthenticatedUser{}
+	if _, err := c.call(ctx, "GET", "authenticated_user", nil, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// MyItems lists items of authenticated user in descending order of created time.
+func (c *Client) MyItems(ctx context.Context, opts *ListOptions) (Posts, *Response, error) {
+	ps := Posts{}
+	resp, err := c.call(ctx, "GET", withValues("authenticated_user/items", opts.values()), nil, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ps, resp, nil
+}
+
+// User converts AuthenticatedUser to User.
+func (u *AuthenticatedUser) User() User {
+	return User{
+		Id:                u.Id,
+		Description:       u.Description,
+		FacebookId:        u.FacebookId,
+		FolloweesCount:    u.FolloweesCount,
+		FollowersCount:    u.FollowersCount,
+		GithubLoginName:   u.GithubLoginName,
+		ItemsCount:        u.ItemsCount,
+		LinkedinId:        u.LinkedinId,
+		Location:          u.Location,
+		Name:              u.Name,
+		Organization:      u.Organization,
+		PermanentId:       u.PermanentId,
+		ProfileImageUrl:   u.ProfileImageUrl,
+		TwitterScreenName: u.TwitterScreenName,
+		WebsiteUrl:        u.WebsiteUrl,
+	}
+}
+
+// ImageMonthlyUploadUsed returns image upload size used in this month.
+func (u *AuthenticatedUser) ImageMonthlyUploadUsed() int {
+	return u.ImageMonthlyUploadLimit - u.ImageMonthlyUploadRemaining
+}
@@ -0,0 +1,54 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestMe(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/authenticated_user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(testAuthenticatedUserJson)
+	})
+
+	u, err := client.Me(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !AuthenticatedUserValueEqual(&testAuthenticatedUser, u) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testAuthenticatedUser, u)
+	}
+}
+
+func TestMyItems(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/authenticated_user/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "page=1&per_page=20"; r.URL.RawQuery != want {
+			t.Errorf("Query not matched.\nwant: %v\nhave: %v\n", want, r.URL.RawQuery)
+		}
+		w.Write(testPostsJson)
+	})
+
+	ps, _, err := client.MyItems(context.Background(), &ListOptions{Page: 1, PerPage: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 1 || !PostValueEqual(&testPosts[0], &ps[0]) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts, ps)
+	}
+}
+
+func TestAuthenticatedUserUser(t *testing.T) {
+	u := testAuthenticatedUser.User()
+	if !UserValueEqual(&testPosts[0].User, &u) {
+		t.Fatalf("User not matched.\nwant: %v\nhave: %v\n", testPosts[0].User, u)
+	}
+	if want := 524288; testAuthenticatedUser.ImageMonthlyUploadUsed() != want {
+		t.Fatalf("Used not matched.\nwant: %v\nhave: %v\n", want, testAuthenticatedUser.ImageMonthlyUploadUsed())
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultBaseUrl is base url of public Qiita api v2.
+const DefaultBaseUrl = "https://qiita.com/api/v2/"
+
+// Client is client for Qiita api v2.
+// Responses are decoded into the structs of this package.
+type Client struct {
+	// BaseUrl is base url of api, must end with slash.
+	BaseUrl *url.URL
+	// AccessToken is sent as Bearer token when not empty.
+	AccessToken string
+	// TokenSource supplies access token instead of AccessToken when not nil.
+	// Request is sent without token when it returns ErrNoToken.
+	TokenSource TokenSource
+	// HttpClient is used to send requests.
+	HttpClient *http.Client
+	// WaitRateLimit makes requests block until rate limit reset,
+	// when no request remains.
+	WaitRateLimit bool
+	// Retry is retry policy for failed requests, no retry when nil.
+	Retry *RetryPolicy
+	// Reactions is registry of reaction names accepted by Add*Reaction.
+	// Register custom reactions of Qiita:Team to it.
+	// Names are not checked when nil.
+	Reactions *ReactionRegistry
+
+	rate *rateLimiter
+}
+
+// NewClient returns new Client for public Qiita.
+// If httpClient is nil, http.DefaultClient is used.
+func NewClient(accessToken string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	baseUrl, _ := url.Parse(DefaultBaseUrl)
+	return &Client{
+		BaseUrl:     baseUrl,
+		AccessToken: accessToken,
+		HttpClient:  httpClient,
+		Reactions:   NewReactionRegistry(),
+		rate:        &rateLimiter{},
+	}
+}
+
+// NewRequest creates api request. path is resolved relative to BaseUrl,
+// and body is encoded as json when not nil.
+func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	u, err := c.BaseUrl.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	token := c.AccessToken
+	if c.TokenSource != nil {
+		// Without token, request is sent unauthenticated for public api.
+		if token, err = c.TokenSource.Token(ctx); err != nil && err != ErrNoToken {
+			return nil, err
+		}
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req, nil
+}
+
+// Response is wrapper of http.Response with values parsed from headers.
+type Response struct {
+	*http.Response
+
+	// Pages parsed from "Link" header, 0 when not available.
+	FirstPage int
+	PrevPage  int
+	NextPage  int
+	LastPage  int
+	// TotalCount is parsed from "Total-Count" header.
+	TotalCount int
+	// RateLimit is parsed from rate limit headers.
+	RateLimit RateLimit
+}
+
+func newResponse(r *http.Response) *Response {
+	resp := &Response{Response: r}
+	resp.parseLink()
+	resp.TotalCount, _ = strconv.Atoi(r.Header.Get("Total-Count"))
+	resp.RateLimit, _ = parseRateLimit(r.Header)
+	return resp
+}
+
+// Do sends api request and decodes json response body into v.
+// v is ignored when nil. Non 2xx response is returned as *APIError.
+// If WaitRateLimit is set, Do blocks until rate limit reset.
+// If Retry is set, failed request is retried according to it.
+func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := c.do(req, v)
+		delay, ok := c.Retry.retry(req, resp, err, attempt)
+		if !ok {
+			if e, isAPIError := err.(*APIError); isAPIError {
+				e.Attempts = attempt
+			}
+			return resp, err
+		}
+		if err := sleep(req.Context(), delay); err != nil {
+			return resp, err
+		}
+		if req, err = rewind(req); err != nil {
+			return resp, err
+		}
+	}
+}
+
+// do sends api request once.
+func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
+	if c.WaitRateLimit {
+		if err := c.rate.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	r, err := c.HttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	resp := newResponse(r)
+	if resp.RateLimit.Limit > 0 {
+		c.rate.set(resp.RateLimit)
+	}
+	if r.StatusCode < 200 || r.StatusCode > 299 {
+		return resp, newAPIError(r)
+	}
+	if v == nil {
+		return resp, nil
+	}
+	return resp, json.NewDecoder(r.Body).Decode(v)
+}
+
+// call creates request and sends it by Do.
+func (c *Client) call(ctx context.Context, method, path string, body, v interface{}) (*Response, error) {
+	req, err := c.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req, v)
+}
+
+// check calls api which returns 204 for true and 404 for false.
+func (c *Client) check(ctx context.Context, path string) (bool, error) {
+	_, err := c.call(ctx, "GET", path, nil, nil)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,67 @@
+package qiitago
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// setup starts test server and returns client connected to it.
+func setup() (*Client, *http.ServeMux, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	client := NewClient("token", nil)
+	client.BaseUrl, _ = url.Parse(server.URL + "/api/v2/")
+	return client, mux, server.Close
+}
+
+// firstJson returns first element of json array.
+func firstJson(t *testing.T, b []byte) []byte {
+	rs := []json.RawMessage{}
+	if err := json.Unmarshal(b, &rs); err != nil {
+		t.Fatal(err)
+	}
+	return rs[0]
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	if r.Method != want {
+		t.Errorf("Request method not matched.\nwant: %v\nhave: %v\n", want, r.Method)
+	}
+}
+
+// testBody checks request body is json equal to want.
+func testBody(t *testing.T, r *http.Request, want []byte) {
+	var w, h interface{}
+	if err := json.Unmarshal(want, &w); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.NewDecoder(r.Body).Decode(&h); err != nil {
+		t.Errorf("Request body not json: %v", err)
+		return
+	}
+	if !reflect.DeepEqual(w, h) {
+		t.Errorf("Request body not matched.\nwant: %v\nhave: %v\n", w, h)
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	c := NewClient("token", nil)
+	req, err := c.NewRequest(context.Background(), "POST", "items", &testPostTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := DefaultBaseUrl + "items"; req.URL.String() != want {
+		t.Fatalf("Url not matched.\nwant: %v\nhave: %v\n", want, req.URL)
+	}
+	if want := "Bearer token"; req.Header.Get("Authorization") != want {
+		t.Fatalf("Authorization not matched.\nwant: %v\nhave: %v\n", want, req.Header.Get("Authorization"))
+	}
+	if want := "application/json"; req.Header.Get("Content-Type") != want {
+		t.Fatalf("Content-Type not matched.\nwant: %v\nhave: %v\n", want, req.Header.Get("Content-Type"))
+	}
+}
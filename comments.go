@@ -0,0 +1,52 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/url"
+)
+
+// ListItemComments lists comments of item in descending order of created time.
+func (c *Client) ListItemComments(ctx context.Context, itemId string) (Comments, error) {
+	cs := Comments{}
+	if _, err := c.call(ctx, "GET", "items/"+url.PathEscape(itemId)+"/comments", nil, &cs); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// GetComment gets comment by id.
+func (c *Client) GetComment(ctx context.Context, id string) (*Comment, error) {
+	cm := &Comment{}
+	if _, err := c.call(ctx, "GET", "comments/"+url.PathEscape(id), nil, cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// CreateComment creates new comment on item.
+func (c *Client) CreateComment(ctx context.Context, itemId string, body string) (*Comment, error) {
+	cm := &Comment{}
+	if _, err := c.call(ctx, "POST", "items/"+url.PathEscape(itemId)+"/comments", &PostComment{Body: body}, cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// UpdateComment updates body of comment by id.
+func (c *Client) UpdateComment(ctx context.Context, id string, body string) (*Comment, error) {
+	cm := &Comment{}
+	if _, err := c.call(ctx, "PATCH", "comments/"+url.PathEscape(id), &PostComment{Body: body}, cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// DeleteComment deletes comment by id.
+func (c *Client) DeleteComment(ctx context.Context, id string) error {
+	_, err := c.call(ctx, "DELETE", "comments/"+url.PathEscape(id), nil, nil)
+	return err
+}
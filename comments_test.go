@@ -0,0 +1,91 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestListItemComments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte("[" + string(testCommentJson) + "]"))
+	})
+
+	cs, err := client.ListItemComments(context.Background(), "4bd431809afb1bb99e4f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 1 || !CommentValueEqual(&testComment, &cs[0]) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testComment, cs)
+	}
+}
+
+func TestGetComment(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/comments/3391f50c35f953abfc4f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(testCommentJson)
+	})
+
+	c, err := client.GetComment(context.Background(), "3391f50c35f953abfc4f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !CommentValueEqual(&testComment, c) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testComment, c)
+	}
+}
+
+func TestCreateComment(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"body":"# Example"}`))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(testCommentJson)
+	})
+
+	c, err := client.CreateComment(context.Background(), "4bd431809afb1bb99e4f", "# Example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !CommentValueEqual(&testComment, c) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testComment, c)
+	}
+}
+
+func TestUpdateComment(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/comments/3391f50c35f953abfc4f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, []byte(`{"body":"# Example"}`))
+		w.Write(testCommentJson)
+	})
+
+	c, err := client.UpdateComment(context.Background(), "3391f50c35f953abfc4f", "# Example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !CommentValueEqual(&testComment, c) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testComment, c)
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/comments/3391f50c35f953abfc4f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteComment(context.Background(), "3391f50c35f953abfc4f"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Change is changed field between 2 values.
+// Path is json field path like "user.location" or "tags[0].versions",
+// and Old and New are field values, nil for null or missing element.
+type Change struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Old, c.New)
+}
+
+// Changes is array of Change.
+type Changes []Change
+
+// Diff returns changed fields from a to b, which are values or
+// pointers of same qiitago type. Slices of struct are compared by
+// index, and other slices and maps are compared as a whole.
+func Diff(a, b interface{}) (Changes, error) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() {
+		return nil, fmt.Errorf("qiitago: diff of untyped nil")
+	}
+	if va.Type() != vb.Type() {
+		return nil, fmt.Errorf("qiitago: diff of different types %s and %s", va.Type(), vb.Type())
+	}
+	cs := Changes{}
+	diffValue(&cs, "", va, vb)
+	return cs, nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// valueOf returns interface of v, nil for nil pointer.
+func valueOf(v reflect.Value) interface{} {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+// jsonName returns json field name of struct field, empty when ignored.
+func jsonName(f reflect.StructField) string {
+	if f.PkgPath != "" {
+		return ""
+	}
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func diffValue(cs *Changes, path string, a, b reflect.Value) {
+	switch {
+	case a.Kind() == reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				*cs = append(*cs, Change{path, valueOf(a), valueOf(b)})
+			}
+			return
+		}
+		diffValue(cs, path, a.Elem(), b.Elem())
+	case a.Type() == timeType:
+		if !a.Interface().(time.Time).Equal(b.Interface().(time.Time)) {
+			*cs = append(*cs, Change{path, a.Interface(), b.Interface()})
+		}
+	case a.Kind() == reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if name := jsonName(a.Type().Field(i)); name != "" {
+				diffValue(cs, joinPath(path, name), a.Field(i), b.Field(i))
+			}
+		}
+	case a.Kind() == reflect.Slice && a.Type().Elem().Kind() == reflect.Struct:
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= a.Len():
+				*cs = append(*cs, Change{p, nil, b.Index(i).Interface()})
+			case i >= b.Len():
+				*cs = append(*cs, Change{p, a.Index(i).Interface(), nil})
+			default:
+				diffValue(cs, p, a.Index(i), b.Index(i))
+			}
+		}
+	case a.Kind() == reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				*cs = append(*cs, Change{path, a.Interface(), b.Interface()})
+			}
+			return
+		}
+		if a.Elem().Type() != b.Elem().Type() {
+			*cs = append(*cs, Change{path, a.Interface(), b.Interface()})
+			return
+		}
+		diffValue(cs, path, a.Elem(), b.Elem())
+	case a.Kind() == reflect.Slice || a.Kind() == reflect.Map:
+		if a.Len() != b.Len() || (a.Len() > 0 && !reflect.DeepEqual(a.Interface(), b.Interface())) {
+			*cs = append(*cs, Change{path, a.Interface(), b.Interface()})
+		}
+	case !a.Type().Comparable():
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*cs = append(*cs, Change{path, a.Interface(), b.Interface()})
+		}
+	default:
+		if a.Interface() != b.Interface() {
+			*cs = append(*cs, Change{path, a.Interface(), b.Interface()})
+		}
+	}
+}
+
+// mustDiff returns Diff of same type values.
+func mustDiff(a, b interface{}) Changes {
+	cs, _ := Diff(a, b)
+	return cs
+}
+
+// Diff returns changed fields from p to p2.
+func (p *Post) Diff(p2 *Post) Changes {
+	if p.Equal(p2) {
+		return Changes{}
+	}
+	return mustDiff(p, p2)
+}
+
+// Diff returns changed fields from u to u2.
+func (u *User) Diff(u2 *User) Changes {
+	if u.Equal(u2) {
+		return Changes{}
+	}
+	return mustDiff(u, u2)
+}
+
+// Diff returns changed fields from u to u2.
+func (u *AuthenticatedUser) Diff(u2 *AuthenticatedUser) Changes {
+	if u.Equal(u2) {
+		return Changes{}
+	}
+	return mustDiff(u, u2)
+}
+
+// Diff returns changed fields from c to c2.
+func (c *Comment) Diff(c2 *Comment) Changes {
+	if c.Equal(c2) {
+		return Changes{}
+	}
+	return mustDiff(c, c2)
+}
+
+// Diff returns changed fields from p to p2.
+func (p *Project) Diff(p2 *Project) Changes {
+	if p.Equal(p2) {
+		return Changes{}
+	}
+	return mustDiff(p, p2)
+}
+
+// Diff returns changed fields from t to t2.
+func (t *Template) Diff(t2 *Template) Changes {
+	if t.Equal(t2) {
+		return Changes{}
+	}
+	return mustDiff(t, t2)
+}
+
+// Diff returns changed fields from r to r2.
+func (r *Reaction) Diff(r2 *Reaction) Changes {
+	if r.Equal(r2) {
+		return Changes{}
+	}
+	return mustDiff(r, r2)
+}
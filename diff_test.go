@@ -0,0 +1,103 @@
+package qiitago
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffPost(t *testing.T) {
+	p1, p2 := testPosts[0], testPosts[0]
+	if cs := p1.Diff(&p2); len(cs) != 0 {
+		t.Fatalf("No change expected: %v", cs)
+	}
+
+	newLocation := "Osaka, Japan"
+	p2.Title = "New title"
+	p2.User.Location = &newLocation
+	p2.User.WebsiteUrl = nil
+	p2.Group = nil
+	p2.UpdatedAt = time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	p2.Tags = Taggings{
+		Tagging{Name: "Ruby", Versions: []string{"0.0.2"}},
+		Tagging{Name: "Go"},
+	}
+	want := Changes{
+		{"group", *p1.Group, nil},
+		{"tags[0].versions", []string{"0.0.1"}, []string{"0.0.2"}},
+		{"tags[1]", nil, Tagging{Name: "Go"}},
+		{"title", "Example title", "New title"},
+		{"updated_at", p1.UpdatedAt, p2.UpdatedAt},
+		{"user.location", "Tokyo, Japan", "Osaka, Japan"},
+		{"user.website_url", "http://yaotti.hatenablog.com", nil},
+	}
+	if cs := p1.Diff(&p2); !reflect.DeepEqual(want, cs) {
+		t.Fatalf("Changes not matched.\nwant: %v\nhave: %v\n", want, cs)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	r := testReactions[0]
+	r.Name = "smile"
+	if cs := testReactions[0].Diff(&r); len(cs) != 1 || cs[0].Path != "name" {
+		t.Fatalf("Changes not matched: %v", cs)
+	}
+	tmpl := testTemplates[0]
+	tmpl.ExpandedTags = nil
+	if cs := testTemplates[0].Diff(&tmpl); len(cs) != 1 || cs[0].Path != "expanded_tags[0]" {
+		t.Fatalf("Changes not matched: %v", cs)
+	}
+	au := testAuthenticatedUser
+	au.TeamOnly = true
+	if cs := testAuthenticatedUser.Diff(&au); len(cs) != 1 || cs[0].Path != "team_only" {
+		t.Fatalf("Changes not matched: %v", cs)
+	}
+	c, p, u := testComment, testProjects[0], testComment.User
+	for _, cs := range []Changes{testComment.Diff(&c), testProjects[0].Diff(&p), testComment.User.Diff(&u)} {
+		if len(cs) != 0 {
+			t.Fatalf("No change expected: %v", cs)
+		}
+	}
+	if _, err := Diff(nil, &testComment); err == nil {
+		t.Fatal("Error expected for nil.")
+	}
+	if _, err := Diff(&testTeam, &testComment); err == nil {
+		t.Fatal("Error expected for different types.")
+	}
+}
+
+func TestDiffUncomparable(t *testing.T) {
+	c1 := TokenConfig{AccessToken: "public", Teams: map[string]string{"increments": "team"}}
+	c2 := TokenConfig{AccessToken: "public", Teams: map[string]string{"increments": "new"}}
+	cs, err := Diff(c1, c2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Changes{{"teams", c1.Teams, c2.Teams}}); !reflect.DeepEqual(want, cs) {
+		t.Fatalf("Changes not matched.\nwant: %v\nhave: %v\n", want, cs)
+	}
+	if cs, _ := Diff(c1, c1); len(cs) != 0 {
+		t.Fatalf("No change expected: %v", cs)
+	}
+
+	tests := []struct {
+		a, b Change
+		want Changes
+	}{
+		{Change{Old: []int{1}}, Change{Old: []int{2}}, Changes{{"Old", []int{1}, []int{2}}}},
+		{Change{Old: []int{1}}, Change{Old: []int{1}}, Changes{}},
+		{Change{Old: "a"}, Change{Old: 1}, Changes{{"Old", "a", 1}}},
+		{Change{Old: "a"}, Change{}, Changes{{"Old", "a", nil}}},
+		{Change{Old: "a"}, Change{Old: "b"}, Changes{{"Old", "a", "b"}}},
+		{Change{}, Change{}, Changes{}},
+	}
+	for _, tt := range tests {
+		cs, err := Diff(tt.a, tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tt.want, cs) {
+			t.Errorf("Changes not matched.\nwant: %v\nhave: %v\n", tt.want, cs)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var teamIdRegexp = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// ValidTeamId reports whether team id is usable as subdomain,
+// consisting of lower case letters, digits and hyphens.
+func ValidTeamId(teamId string) bool {
+	return teamIdRegexp.MatchString(teamId)
+}
+
+// TeamBaseUrl returns base url of Qiita:Team api for team id,
+// like "https://<team>.qiita.com/api/v2/".
+// It returns error for invalid team id, see ValidTeamId.
+func TeamBaseUrl(teamId string) (string, error) {
+	if !ValidTeamId(teamId) {
+		return "", fmt.Errorf("qiitago: invalid team id %q", teamId)
+	}
+	return "https://" + teamId + ".qiita.com/api/v2/", nil
+}
+
+// parseBaseUrl parses rawurl as base url, appending slash if missing.
+func parseBaseUrl(rawurl string) (*url.URL, error) {
+	if !strings.HasSuffix(rawurl, "/") {
+		rawurl += "/"
+	}
+	return url.Parse(rawurl)
+}
+
+// SetBaseUrl sets base url of api, such as TeamBaseUrl
+// or url of Qiita compatible server.
+func (c *Client) SetBaseUrl(rawurl string) error {
+	u, err := parseBaseUrl(rawurl)
+	if err != nil {
+		return err
+	}
+	c.BaseUrl = u
+	return nil
+}
+
+// NewTeamClient returns new Client for Qiita:Team of team id.
+// It returns error for invalid team id, see ValidTeamId.
+func NewTeamClient(teamId string, accessToken string, httpClient *http.Client) (*Client, error) {
+	baseUrl, err := TeamBaseUrl(teamId)
+	if err != nil {
+		return nil, err
+	}
+	c := NewClient(accessToken, httpClient)
+	c.BaseUrl, _ = parseBaseUrl(baseUrl)
+	return c, nil
+}
+
+// ClientSet holds clients for public Qiita and each Qiita:Team.
+// Clients share http client, access token, retry policy
+// and rate limit status of Public client.
+type ClientSet struct {
+	// TeamTokenSource returns TokenSource for team, when token differs
+	// among teams. Public client's token is used when nil.
+	TeamTokenSource func(teamId string) TokenSource
+
+	public *Client
+
+	mu    sync.Mutex
+	teams map[string]*Client
+}
+
+// NewClientSet returns new ClientSet.
+// If httpClient is nil, http.DefaultClient is used.
+func NewClientSet(accessToken string, httpClient *http.Client) *ClientSet {
+	return &ClientSet{
+		public: NewClient(accessToken, httpClient),
+		teams:  map[string]*Client{},
+	}
+}
+
+// Public returns client for public Qiita.
+// Configure it before calling Team to share settings with team clients.
+func (s *ClientSet) Public() *Client {
+	return s.public
+}
+
+// Team returns client for Qiita:Team of team id,
+// created on first call with settings of Public client.
+// It returns error for invalid team id, see ValidTeamId.
+func (s *ClientSet) Team(teamId string) (*Client, error) {
+	baseUrl, err := TeamBaseUrl(teamId)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.teams[teamId]; ok {
+		return c, nil
+	}
+	c := *s.public
+	c.BaseUrl, _ = parseBaseUrl(baseUrl)
+	// Custom reactions differ among teams.
+	c.Reactions = NewReactionRegistry()
+	if s.TeamTokenSource != nil {
+		c.TokenSource = s.TeamTokenSource(teamId)
+	}
+	s.teams[teamId] = &c
+	return &c, nil
+}
+
+// Teams returns team ids of clients created by Team.
+func (s *ClientSet) Teams() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]string, 0, len(s.teams))
+	for id := range s.teams {
+		ids = append(ids, id)
+	}
+	return ids
+}
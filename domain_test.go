@@ -0,0 +1,92 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewTeamClient(t *testing.T) {
+	c, err := NewTeamClient("increments", "token", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://increments.qiita.com/api/v2/"; c.BaseUrl.String() != want {
+		t.Fatalf("BaseUrl not matched.\nwant: %v\nhave: %v\n", want, c.BaseUrl)
+	}
+}
+
+func TestSetBaseUrl(t *testing.T) {
+	c := NewClient("token", nil)
+	if err := c.SetBaseUrl("https://qiita.example.com/api/v2"); err != nil {
+		t.Fatal(err)
+	}
+	req, err := c.NewRequest(context.Background(), "GET", "items", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://qiita.example.com/api/v2/items"; req.URL.String() != want {
+		t.Fatalf("Url not matched.\nwant: %v\nhave: %v\n", want, req.URL)
+	}
+}
+
+func TestClientSet(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handleRateLimit(mux, 10, time.Date(2000, 1, 1, 1, 0, 0, 0, time.UTC))
+
+	s := NewClientSet("token", nil)
+	s.Public().Retry = DefaultRetryPolicy()
+	team, err := s.Team("increments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := s.Team("increments"); team != again {
+		t.Fatal("Same team client expected.")
+	}
+	if want := "https://increments.qiita.com/api/v2/"; team.BaseUrl.String() != want {
+		t.Fatalf("BaseUrl not matched.\nwant: %v\nhave: %v\n", want, team.BaseUrl)
+	}
+	if team.AccessToken != "token" || team.HttpClient != http.DefaultClient || team.Retry != s.Public().Retry {
+		t.Fatalf("Settings not shared: %+v", team)
+	}
+	if team.Reactions == s.Public().Reactions || !team.Reactions.Valid(ReactionPlusOne) {
+		t.Fatal("Own reaction registry with default reactions expected.")
+	}
+	if ids := s.Teams(); len(ids) != 1 || ids[0] != "increments" {
+		t.Fatalf("Teams not matched: %v", ids)
+	}
+
+	team.BaseUrl = client.BaseUrl
+	if _, _, err := team.ListItems(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if rl := s.Public().RateLimit(); rl.Remaining != 10 {
+		t.Fatalf("RateLimit not shared: %v", rl)
+	}
+}
+
+func TestInvalidTeamId(t *testing.T) {
+	s := NewClientSet("token", nil)
+	for _, id := range []string{"", "evil.example.com#", "evil.com/", "Increments", "a b", "team@x"} {
+		if ValidTeamId(id) {
+			t.Errorf("Invalid team id expected: %q", id)
+		}
+		if _, err := TeamBaseUrl(id); err == nil {
+			t.Errorf("Error expected for TeamBaseUrl(%q)", id)
+		}
+		if c, err := NewTeamClient(id, "token", nil); err == nil || c != nil {
+			t.Errorf("Error expected for NewTeamClient(%q)", id)
+		}
+		if c, err := s.Team(id); err == nil || c != nil {
+			t.Errorf("Error expected for Team(%q)", id)
+		}
+	}
+	if ids := s.Teams(); len(ids) != 0 {
+		t.Fatalf("No team client expected: %v", ids)
+	}
+	if !ValidTeamId("my-team-01") {
+		t.Fatal("Valid team id expected.")
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+// Equal methods check instance value equality.
+// They never panic on nil, nil equals nil and nil is unequal to non nil,
+// for both receiver and pointer fields for null in Qiita api.
+
+func stringPtrEqual(s1, s2 *string) bool {
+	if s1 == nil || s2 == nil {
+		return s1 == s2
+	}
+	return *s1 == *s2
+}
+
+func intPtrEqual(i1, i2 *int) bool {
+	if i1 == nil || i2 == nil {
+		return i1 == i2
+	}
+	return *i1 == *i2
+}
+
+func boolPtrEqual(b1, b2 *bool) bool {
+	if b1 == nil || b2 == nil {
+		return b1 == b2
+	}
+	return *b1 == *b2
+}
+
+// stringsEqual treats nil and empty as equal.
+func stringsEqual(s1, s2 []string) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal check value equality with u2.
+func (u *User) Equal(u2 *User) bool {
+	if u == nil || u2 == nil {
+		return u == u2
+	}
+	return u.Id == u2.Id &&
+		stringPtrEqual(u.Description, u2.Description) &&
+		stringPtrEqual(u.FacebookId, u2.FacebookId) &&
+		u.FolloweesCount == u2.FolloweesCount &&
+		u.FollowersCount == u2.FollowersCount &&
+		stringPtrEqual(u.GithubLoginName, u2.GithubLoginName) &&
+		u.ItemsCount == u2.ItemsCount &&
+		stringPtrEqual(u.LinkedinId, u2.LinkedinId) &&
+		stringPtrEqual(u.Location, u2.Location) &&
+		stringPtrEqual(u.Name, u2.Name) &&
+		stringPtrEqual(u.Organization, u2.Organization) &&
+		u.PermanentId == u2.PermanentId &&
+		u.ProfileImageUrl == u2.ProfileImageUrl &&
+		stringPtrEqual(u.TwitterScreenName, u2.TwitterScreenName) &&
+		stringPtrEqual(u.WebsiteUrl, u2.WebsiteUrl)
+}
+
+// Equal check value equality with u2.
+func (u *AuthenticatedUser) Equal(u2 *AuthenticatedUser) bool {
+	if u == nil || u2 == nil {
+		return u == u2
+	}
+	user, user2 := u.User(), u2.User()
+	return user.Equal(&user2) &&
+		u.ImageMonthlyUploadLimit == u2.ImageMonthlyUploadLimit &&
+		u.ImageMonthlyUploadRemaining == u2.ImageMonthlyUploadRemaining &&
+		u.TeamOnly == u2.TeamOnly
+}
+
+// Equal check value equality with t2.
+func (t *Tagging) Equal(t2 *Tagging) bool {
+	if t == nil || t2 == nil {
+		return t == t2
+	}
+	return t.Name == t2.Name && stringsEqual(t.Versions, t2.Versions)
+}
+
+// Equal check value equality with ts2, nil and empty are equal.
+func (ts Taggings) Equal(ts2 Taggings) bool {
+	if len(ts) != len(ts2) {
+		return false
+	}
+	for i := range ts {
+		if !ts[i].Equal(&ts2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal check value equality with t2.
+func (t *Tag) Equal(t2 *Tag) bool {
+	if t == nil || t2 == nil {
+		return t == t2
+	}
+	return t.Id == t2.Id &&
+		t.FollowersCount == t2.FollowersCount &&
+		stringPtrEqual(t.IconUrl, t2.IconUrl) &&
+		t.ItemsCount == t2.ItemsCount
+}
+
+// Equal check value equality with g2.
+func (g *Group) Equal(g2 *Group) bool {
+	if g == nil || g2 == nil {
+		return g == g2
+	}
+	return g.Id == g2.Id &&
+		g.CreatedAt.Equal(g2.CreatedAt) &&
+		g.Name == g2.Name &&
+		g.Private == g2.Private &&
+		g.UpdatedAt.Equal(g2.UpdatedAt) &&
+		g.UrlName == g2.UrlName
+}
+
+// Equal check value equality with g2.
+func (g *PostGroup) Equal(g2 *PostGroup) bool {
+	if g == nil || g2 == nil {
+		return g == g2
+	}
+	return *g == *g2
+}
+
+// Equal check value equality with m2.
+func (m *GroupMember) Equal(m2 *GroupMember) bool {
+	if m == nil || m2 == nil {
+		return m == m2
+	}
+	return *m == *m2
+}
+
+// Equal check value equality with p2.
+func (p *Post) Equal(p2 *Post) bool {
+	if p == nil || p2 == nil {
+		return p == p2
+	}
+	return p.Id == p2.Id &&
+		p.RenderedBody == p2.RenderedBody &&
+		p.Body == p2.Body &&
+		p.Coediting == p2.Coediting &&
+		p.CommentsCount == p2.CommentsCount &&
+		p.CreatedAt.Equal(p2.CreatedAt) &&
+		p.Group.Equal(p2.Group) &&
+		p.LikesCount == p2.LikesCount &&
+		p.Private == p2.Private &&
+		p.ReactionsCount == p2.ReactionsCount &&
+		p.Tags.Equal(p2.Tags) &&
+		p.Title == p2.Title &&
+		p.UpdatedAt.Equal(p2.UpdatedAt) &&
+		p.Url == p2.Url &&
+		p.User.Equal(&p2.User) &&
+		intPtrEqual(p.PageViewsCount, p2.PageViewsCount)
+}
+
+// Equal check value equality with p2.
+func (p *PostItem) Equal(p2 *PostItem) bool {
+	if p == nil || p2 == nil {
+		return p == p2
+	}
+	return p.Body == p2.Body &&
+		p.Coediting == p2.Coediting &&
+		stringPtrEqual(p.GroupUrlName, p2.GroupUrlName) &&
+		p.Gist == p2.Gist &&
+		p.Private == p2.Private &&
+		p.Tags.Equal(p2.Tags) &&
+		p.Title == p2.Title &&
+		p.Tweet == p2.Tweet
+}
+
+// Equal check value equality with p2.
+func (p *PatchItem) Equal(p2 *PatchItem) bool {
+	if p == nil || p2 == nil {
+		return p == p2
+	}
+	return stringPtrEqual(p.Body, p2.Body) &&
+		boolPtrEqual(p.Coediting, p2.Coediting) &&
+		stringPtrEqual(p.GroupUrlName, p2.GroupUrlName) &&
+		boolPtrEqual(p.Private, p2.Private) &&
+		p.Tags.Equal(p2.Tags) &&
+		stringPtrEqual(p.Title, p2.Title)
+}
+
+// Equal check value equality with c2.
+func (c *Comment) Equal(c2 *Comment) bool {
+	if c == nil || c2 == nil {
+		return c == c2
+	}
+	return c.Id == c2.Id &&
+		c.Body == c2.Body &&
+		c.CreatedAt.Equal(c2.CreatedAt) &&
+		c.RenderedBody == c2.RenderedBody &&
+		c.UpdatedAt.Equal(c2.UpdatedAt) &&
+		c.User.Equal(&c2.User)
+}
+
+// Equal check value equality with c2.
+func (c *PostComment) Equal(c2 *PostComment) bool {
+	if c == nil || c2 == nil {
+		return c == c2
+	}
+	return *c == *c2
+}
+
+// Equal check value equality with l2.
+func (l *Like) Equal(l2 *Like) bool {
+	if l == nil || l2 == nil {
+		return l == l2
+	}
+	return l.CreatedAt.Equal(l2.CreatedAt) && l.User.Equal(&l2.User)
+}
+
+// Equal check value equality with t2.
+func (t *Team) Equal(t2 *Team) bool {
+	if t == nil || t2 == nil {
+		return t == t2
+	}
+	return *t == *t2
+}
+
+// Equal check value equality with m2.
+func (m *TeamMember) Equal(m2 *TeamMember) bool {
+	if m == nil || m2 == nil {
+		return m == m2
+	}
+	return *m == *m2
+}
+
+// Equal check value equality with i2.
+func (i *TeamInvitation) Equal(i2 *TeamInvitation) bool {
+	if i == nil || i2 == nil {
+		return i == i2
+	}
+	return *i == *i2
+}
+
+// Equal check value equality with t2.
+func (t *Template) Equal(t2 *Template) bool {
+	if t == nil || t2 == nil {
+		return t == t2
+	}
+	return t.Id == t2.Id &&
+		t.Body == t2.Body &&
+		t.Name == t2.Name &&
+		t.ExpandedBody == t2.ExpandedBody &&
+		t.ExpandedTags.Equal(t2.ExpandedTags) &&
+		t.ExpandedTitle == t2.ExpandedTitle &&
+		t.Tags.Equal(t2.Tags) &&
+		t.Title == t2.Title
+}
+
+// Equal check value equality with t2.
+func (t *PostTemplate) Equal(t2 *PostTemplate) bool {
+	if t == nil || t2 == nil {
+		return t == t2
+	}
+	return t.Body == t2.Body &&
+		t.Name == t2.Name &&
+		t.Tags.Equal(t2.Tags) &&
+		t.Title == t2.Title
+}
+
+// Equal check value equality with t2.
+func (t *ExpandedTemplate) Equal(t2 *ExpandedTemplate) bool {
+	if t == nil || t2 == nil {
+		return t == t2
+	}
+	return t.Body == t2.Body &&
+		t.Tags.Equal(t2.Tags) &&
+		t.Title == t2.Title
+}
+
+// Equal check value equality with p2.
+func (p *Project) Equal(p2 *Project) bool {
+	if p == nil || p2 == nil {
+		return p == p2
+	}
+	return p.Id == p2.Id &&
+		p.RenderedBody == p2.RenderedBody &&
+		p.Archived == p2.Archived &&
+		p.Body == p2.Body &&
+		p.CreatedAt.Equal(p2.CreatedAt) &&
+		p.Name == p2.Name &&
+		p.ReactionsCount == p2.ReactionsCount &&
+		p.UpdatedAt.Equal(p2.UpdatedAt)
+}
+
+// Equal check value equality with p2.
+func (p *PostProject) Equal(p2 *PostProject) bool {
+	if p == nil || p2 == nil {
+		return p == p2
+	}
+	return p.Archived == p2.Archived &&
+		p.Body == p2.Body &&
+		p.Name == p2.Name &&
+		p.Tags.Equal(p2.Tags)
+}
+
+// Equal check value equality with r2.
+func (r *Reaction) Equal(r2 *Reaction) bool {
+	if r == nil || r2 == nil {
+		return r == r2
+	}
+	return r.CreatedAt.Equal(r2.CreatedAt) &&
+		r.ImageUrl == r2.ImageUrl &&
+		r.Name == r2.Name &&
+		r.User.Equal(&r2.User)
+}
+
+// Equal check value equality with r2.
+func (r *PostReaction) Equal(r2 *PostReaction) bool {
+	if r == nil || r2 == nil {
+		return r == r2
+	}
+	return *r == *r2
+}
+
+// Equal check value equality with t2.
+func (t *AccessToken) Equal(t2 *AccessToken) bool {
+	if t == nil || t2 == nil {
+		return t == t2
+	}
+	if len(t.Scopes) != len(t2.Scopes) {
+		return false
+	}
+	for i := range t.Scopes {
+		if t.Scopes[i] != t2.Scopes[i] {
+			return false
+		}
+	}
+	return t.ClientId == t2.ClientId && t.Token == t2.Token
+}
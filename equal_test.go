@@ -0,0 +1,117 @@
+package qiitago
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var testNullUserJson = []byte(`
+{
+  "description": null,
+  "facebook_id": null,
+  "followees_count": 0,
+  "followers_count": 0,
+  "github_login_name": null,
+  "id": "empty",
+  "items_count": 0,
+  "linkedin_id": null,
+  "location": null,
+  "name": null,
+  "organization": null,
+  "permanent_id": 2,
+  "profile_image_url": "https://example.com/empty.png",
+  "twitter_screen_name": null,
+  "website_url": null
+}
+`)
+
+func TestEqualNull(t *testing.T) {
+	u1, u2 := User{}, User{}
+	for _, u := range []*User{&u1, &u2} {
+		if err := json.Unmarshal(testNullUserJson, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !u1.Equal(&u2) || !UserValueEqual(&u1, &u2) {
+		t.Fatal("Null users expected equal.")
+	}
+	if u1.Equal(&testPosts[0].User) || testPosts[0].User.Equal(&u1) {
+		t.Fatal("Null and non null users expected unequal.")
+	}
+
+	p1, p2 := testPosts[0], testPosts[0]
+	p1.Group, p1.PageViewsCount = nil, nil
+	if p1.Equal(&p2) || p2.Equal(&p1) {
+		t.Fatal("Null and non null group expected unequal.")
+	}
+	p2.Group, p2.PageViewsCount = nil, nil
+	if !p1.Equal(&p2) || !PostValueEqual(&p1, &p2) {
+		t.Fatal("Null groups expected equal.")
+	}
+}
+
+func TestEqualNil(t *testing.T) {
+	var u *User
+	var p *Post
+	var a *AuthenticatedUser
+	if !u.Equal(nil) || u.Equal(&testPosts[0].User) || testPosts[0].User.Equal(nil) {
+		t.Fatal("User nil equality not matched.")
+	}
+	if !p.Equal(nil) || p.Equal(&testPosts[0]) || testPosts[0].Equal(nil) {
+		t.Fatal("Post nil equality not matched.")
+	}
+	if !a.Equal(nil) || a.Equal(&testAuthenticatedUser) {
+		t.Fatal("AuthenticatedUser nil equality not matched.")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	team := testTeam
+	tag := Tag{Id: "qiita"}
+	tmpl := testTemplates[0]
+	tmpl.Tags = Taggings{Tagging{Name: tmpl.Tags[0].Name, Versions: append([]string{}, tmpl.Tags[0].Versions...)}}
+	et := testExpandedTemplate
+	au := testAuthenticatedUser
+	au.TeamOnly = true
+	at := testAccessToken
+	at.Scopes = append(Scopes{}, at.Scopes...)
+	var nilMember *GroupMember
+	tests := []struct {
+		equal bool
+		want  bool
+	}{
+		{team.Equal(&testTeam), true},
+		{tag.Equal(&Tag{Id: "qiita"}), true},
+		{tag.Equal(&Tag{Id: "qiita", IconUrl: &iconUrl}), false},
+		{tmpl.Equal(&testTemplates[0]), true},
+		{et.Equal(&testExpandedTemplate), true},
+		{et.Equal(&ExpandedTemplate{Body: et.Body, Title: et.Title}), false},
+		{testPostTemplate.Equal(&testPostTemplate), true},
+		{testPostProject.Equal(&testPostProject), true},
+		{testPostItem.Equal(&PostItem{Body: testPostItem.Body, Tags: testPostItem.Tags, Title: testPostItem.Title}), false},
+		{testComment.Equal(&testComment), true},
+		{testProjects[0].Equal(&testProjects[0]), true},
+		{testReactions[0].Equal(&testReactions[0]), true},
+		{au.Equal(&testAuthenticatedUser), false},
+		{Taggings(nil).Equal(Taggings{}), true},
+		{testGroupMembers[0].Equal(&testGroupMembers[0]), true},
+		{testGroupMembers[0].Equal(&GroupMember{Id: testGroupMembers[0].Id}), false},
+		{nilMember.Equal(nil), true},
+		{nilMember.Equal(&testGroupMembers[0]), false},
+		{(&PostGroup{Name: "Dev", UrlName: "dev"}).Equal(&PostGroup{Name: "Dev", UrlName: "dev"}), true},
+		{(&PostGroup{Name: "Dev"}).Equal(&PostGroup{Name: "Dev", Private: true}), false},
+		{(&PostComment{Body: "a"}).Equal(&PostComment{Body: "a"}), true},
+		{(&PostComment{Body: "a"}).Equal(nil), false},
+		{testTeamMembers[0].Equal(&testTeamMembers[0]), true},
+		{testTeamInvitations[0].Equal(&TeamInvitation{Email: testTeamInvitations[0].Email}), false},
+		{(&PostReaction{Name: ReactionPlusOne}).Equal(&PostReaction{Name: ReactionPlusOne}), true},
+		{(&PostReaction{Name: ReactionPlusOne}).Equal(&PostReaction{Name: ReactionTada}), false},
+		{at.Equal(&testAccessToken), true},
+		{at.Equal(&AccessToken{ClientId: at.ClientId, Token: at.Token}), false},
+	}
+	for i, tt := range tests {
+		if tt.equal != tt.want {
+			t.Errorf("Equal not matched at %d.\nwant: %v\nhave: %v\n", i, tt.want, tt.equal)
+		}
+	}
+}
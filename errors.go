@@ -0,0 +1,65 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// APIError is struct for error response in Qiita api.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Method     string `json:"-"`
+	Path       string `json:"-"`
+	Message    string `json:"message"`
+	Type       string `json:"type"`
+	// Attempts is number of requests sent, including retries.
+	Attempts int `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("qiitago: %s %s: %d %s (%s)", e.Method, e.Path, e.StatusCode, e.Message, e.Type)
+	if e.Attempts > 1 {
+		msg += fmt.Sprintf(" after %d attempts", e.Attempts)
+	}
+	return msg
+}
+
+// newAPIError creates APIError from non 2xx response.
+// Message is filled with raw body, if body is not json.
+func newAPIError(resp *http.Response) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     resp.Request.Method,
+		Path:       resp.Request.URL.Path,
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	if err := json.Unmarshal(b, e); err != nil || e.Message == "" {
+		e.Message = string(b)
+	}
+	return e
+}
+
+// IsNotFound reports whether err is APIError for not found.
+func IsNotFound(err error) bool {
+	e, ok := err.(*APIError)
+	return ok && (e.StatusCode == http.StatusNotFound || e.Type == "not_found")
+}
+
+// IsUnauthorized reports whether err is APIError for unauthorized.
+func IsUnauthorized(err error) bool {
+	e, ok := err.(*APIError)
+	return ok && (e.StatusCode == http.StatusUnauthorized || e.Type == "unauthorized")
+}
+
+// IsRateLimited reports whether err is APIError for rate limit exceeded.
+// Qiita returns 403 with type "rate_limit_exceeded".
+func IsRateLimited(err error) bool {
+	e, ok := err.(*APIError)
+	return ok && (e.StatusCode == http.StatusTooManyRequests || e.Type == "rate_limit_exceeded")
+}
@@ -0,0 +1,85 @@
+package qiitago
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+var testNotFoundJson = []byte(`
+{
+  "message": "Not found",
+  "type": "not_found"
+}
+`)
+
+func TestAPIError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/none", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write(testNotFoundJson)
+	})
+
+	_, err := client.GetItem(context.Background(), "none")
+	e, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("APIError expected, but %v", err)
+	}
+	want := APIError{
+		StatusCode: http.StatusNotFound,
+		Method:     "GET",
+		Path:       "/api/v2/items/none",
+		Message:    "Not found",
+		Type:       "not_found",
+		Attempts:   1,
+	}
+	if *e != want {
+		t.Fatalf("Error not matched.\nwant: %v\nhave: %v\n", want, *e)
+	}
+	if !IsNotFound(err) || IsUnauthorized(err) || IsRateLimited(err) {
+		t.Fatalf("Error kind not matched: %v", err)
+	}
+}
+
+func TestAPIErrorNotJson(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/bad", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("Bad Gateway"))
+	})
+
+	_, err := client.GetItem(context.Background(), "bad")
+	e, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("APIError expected, but %v", err)
+	}
+	if e.StatusCode != http.StatusBadGateway || e.Message != "Bad Gateway" {
+		t.Fatalf("Error not matched: %v", e)
+	}
+}
+
+func TestIsErrorKind(t *testing.T) {
+	tests := []struct {
+		err          error
+		notFound     bool
+		unauthorized bool
+		rateLimited  bool
+	}{
+		{&APIError{StatusCode: 404, Type: "not_found"}, true, false, false},
+		{&APIError{StatusCode: 401, Type: "unauthorized"}, false, true, false},
+		{&APIError{StatusCode: 403, Type: "rate_limit_exceeded"}, false, false, true},
+		{&APIError{StatusCode: 403, Type: "forbidden"}, false, false, false},
+		{errors.New("not api error"), false, false, false},
+		{nil, false, false, false},
+	}
+	for _, tt := range tests {
+		if IsNotFound(tt.err) != tt.notFound ||
+			IsUnauthorized(tt.err) != tt.unauthorized ||
+			IsRateLimited(tt.err) != tt.rateLimited {
+			t.Errorf("Error kind not matched: %v", tt.err)
+		}
+	}
+}
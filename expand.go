@@ -0,0 +1,108 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// TemplateExpander expands placeholders of Qiita template locally,
+// without calling api. Supported placeholders are:
+//
+//	%{Year}   4 digit year      2000
+//	%{year}   2 digit year      00
+//	%{month}  2 digit month     01
+//	%{day}    2 digit day       01
+//	%{hour}   2 digit hour      09
+//	%{minute} 2 digit minute    05
+//	%{second} 2 digit second    07
+//	%{cweek}  2 digit ISO week  52
+//	%{cwday}  ISO weekday, 1(Mon) to 7(Sun)
+//	%{wday}   short weekday     Sat
+//	%{name}   user name, when UserName is set
+//
+// Unknown placeholders are left as is.
+type TemplateExpander struct {
+	// Now is time to expand date placeholders.
+	Now time.Time
+	// Location is time zone of Now, Now's location is used when nil.
+	Location *time.Location
+	// UserName is value of %{name}.
+	UserName string
+	// Vars is additional placeholders, overriding default ones.
+	Vars map[string]string
+}
+
+var placeholderRegexp = regexp.MustCompile(`%\{(\w+)\}`)
+
+// vars returns value of each placeholder.
+func (e *TemplateExpander) vars() map[string]string {
+	now := e.Now
+	if e.Location != nil {
+		now = now.In(e.Location)
+	}
+	_, week := now.ISOWeek()
+	wday := int(now.Weekday())
+	if wday == 0 {
+		wday = 7
+	}
+	v := map[string]string{
+		"Year":   now.Format("2006"),
+		"year":   now.Format("06"),
+		"month":  now.Format("01"),
+		"day":    now.Format("02"),
+		"hour":   now.Format("15"),
+		"minute": now.Format("04"),
+		"second": now.Format("05"),
+		"cweek":  fmt.Sprintf("%02d", week),
+		"cwday":  fmt.Sprint(wday),
+		"wday":   now.Format("Mon"),
+	}
+	if e.UserName != "" {
+		v["name"] = e.UserName
+	}
+	for k, s := range e.Vars {
+		v[k] = s
+	}
+	return v
+}
+
+func expandString(s string, vars map[string]string) string {
+	return placeholderRegexp.ReplaceAllStringFunc(s, func(p string) string {
+		if v, ok := vars[p[2:len(p)-1]]; ok {
+			return v
+		}
+		return p
+	})
+}
+
+// Expand expands placeholders in title, body and tag names of template.
+func (e *TemplateExpander) Expand(t Template) ExpandedTemplate {
+	vars := e.vars()
+	tags := make(Taggings, len(t.Tags))
+	for i, tag := range t.Tags {
+		tags[i] = Tagging{
+			Name:     expandString(tag.Name, vars),
+			Versions: append([]string(nil), tag.Versions...),
+		}
+	}
+	return ExpandedTemplate{
+		Body:  expandString(t.Body, vars),
+		Tags:  tags,
+		Title: expandString(t.Title, vars),
+	}
+}
+
+// ExpandTemplateLocally expands placeholders of template at now in loc.
+// %{name} is expanded when userName is given.
+func ExpandTemplateLocally(t Template, now time.Time, loc *time.Location, userName ...string) ExpandedTemplate {
+	e := &TemplateExpander{Now: now, Location: loc}
+	if len(userName) > 0 {
+		e.UserName = userName[0]
+	}
+	return e.Expand(t)
+}
@@ -0,0 +1,72 @@
+package qiitago
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExpandTemplateLocally(t *testing.T) {
+	now := time.Date(1999, 12, 31, 15, 0, 0, 0, time.UTC)
+	loc := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	et := ExpandTemplateLocally(testTemplates[0], now, loc)
+	want := ExpandedTemplate{
+		Body:  testTemplates[0].ExpandedBody,
+		Tags:  testTemplates[0].ExpandedTags,
+		Title: "Weekly MTG on 2000/01/01",
+	}
+	if !reflect.DeepEqual(want, et) {
+		t.Fatalf("Expanded not matched.\nwant: %v\nhave: %v\n", want, et)
+	}
+}
+
+func TestExpandTemplateLocallyUserName(t *testing.T) {
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	tmpl := Template{Title: "%{name}", Body: "%{name}"}
+
+	et := ExpandTemplateLocally(tmpl, now, nil, "yaotti")
+	if et.Title != "yaotti" || et.Body != "yaotti" {
+		t.Fatalf("Name not expanded: %v", et)
+	}
+	if et = ExpandTemplateLocally(tmpl, now, nil); et.Title != "%{name}" {
+		t.Fatalf("Name expanded without user name: %v", et)
+	}
+}
+
+func TestTemplateExpander(t *testing.T) {
+	e := &TemplateExpander{Now: time.Date(2000, 1, 2, 9, 5, 7, 0, time.UTC)}
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"%{Year}", "2000"},
+		{"%{year}", "00"},
+		{"%{month}", "01"},
+		{"%{day}", "02"},
+		{"%{hour}", "09"},
+		{"%{minute}", "05"},
+		{"%{second}", "07"},
+		{"%{cweek}", "52"},
+		{"%{cwday}", "7"},
+		{"%{wday}", "Sun"},
+		{"%{unknown}", "%{unknown}"},
+	}
+	for _, tt := range tests {
+		if et := e.Expand(Template{Title: tt.in}); et.Title != tt.want {
+			t.Errorf("%v not matched.\nwant: %v\nhave: %v\n", tt.in, tt.want, et.Title)
+		}
+	}
+}
+
+func TestTemplateExpanderVars(t *testing.T) {
+	e := &TemplateExpander{
+		Now:      time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		UserName: "yaotti",
+		Vars:     map[string]string{"team": "increments", "day": "31"},
+	}
+	et := e.Expand(Template{Body: "%{name} %{team} %{day}"})
+	if want := "yaotti increments 31"; et.Body != want {
+		t.Errorf("Body not matched.\nwant: %v\nhave: %v\n", want, et.Body)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/url"
+)
+
+// Groups are available in Qiita:Team only.
+
+// ListGroups lists groups.
+func (c *Client) ListGroups(ctx context.Context, opts *ListOptions) (Groups, *Response, error) {
+	gs := Groups{}
+	resp, err := c.call(ctx, "GET", withValues("groups", opts.values()), nil, &gs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return gs, resp, nil
+}
+
+// ListAllGroups lists groups of all pages.
+func (c *Client) ListAllGroups(ctx context.Context, opts *ListOptions) (Groups, error) {
+	all := Groups{}
+	err := allPages(opts, func(o *ListOptions) (*Response, error) {
+		gs, resp, err := c.ListGroups(ctx, o)
+		all = append(all, gs...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// GetGroup gets group by url name.
+func (c *Client) GetGroup(ctx context.Context, urlName string) (*Group, error) {
+	g := &Group{}
+	if _, err := c.call(ctx, "GET", "groups/"+url.PathEscape(urlName), nil, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// CreateGroup creates new group.
+func (c *Client) CreateGroup(ctx context.Context, group *PostGroup) (*Group, error) {
+	g := &Group{}
+	if _, err := c.call(ctx, "POST", "groups", group, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// UpdateGroup updates group by url name.
+func (c *Client) UpdateGroup(ctx context.Context, urlName string, group *PostGroup) (*Group, error) {
+	g := &Group{}
+	if _, err := c.call(ctx, "PATCH", "groups/"+url.PathEscape(urlName), group, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// DeleteGroup deletes group by url name.
+func (c *Client) DeleteGroup(ctx context.Context, urlName string) error {
+	_, err := c.call(ctx, "DELETE", "groups/"+url.PathEscape(urlName), nil, nil)
+	return err
+}
+
+// ListGroupMembers lists members of group.
+func (c *Client) ListGroupMembers(ctx context.Context, urlName string, opts *ListOptions) (GroupMembers, *Response, error) {
+	ms := GroupMembers{}
+	resp, err := c.call(ctx, "GET", withValues("groups/"+url.PathEscape(urlName)+"/members", opts.values()), nil, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ms, resp, nil
+}
+
+// AddGroupMember adds user to group, identity is user id or email.
+func (c *Client) AddGroupMember(ctx context.Context, urlName string, identity string) (*GroupMember, error) {
+	m := &GroupMember{}
+	if _, err := c.call(ctx, "POST", "groups/"+url.PathEscape(urlName)+"/members", &PostGroupMember{Identity: identity}, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RemoveGroupMember removes user from group.
+func (c *Client) RemoveGroupMember(ctx context.Context, urlName string, userId string) error {
+	_, err := c.call(ctx, "DELETE", "groups/"+url.PathEscape(urlName)+"/members/"+url.PathEscape(userId), nil, nil)
+	return err
+}
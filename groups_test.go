@@ -0,0 +1,137 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testGroupsJson = []byte(`
+[
+  {
+    "created_at": "2000-01-01T00:00:00+00:00",
+    "id": 1,
+    "name": "Dev",
+    "private": false,
+    "updated_at": "2000-01-01T00:00:00+00:00",
+    "url_name": "dev"
+  }
+]
+`)
+
+var testGroupMembersJson = []byte(`
+[
+  {
+    "id": "yaotti",
+    "name": "Hiroshige Umino",
+    "email": "yaotti@example.com"
+  }
+]
+`)
+
+var testGroupMembers = GroupMembers{
+	GroupMember{
+		Id:    "yaotti",
+		Name:  "Hiroshige Umino",
+		Email: "yaotti@example.com",
+	},
+}
+
+func TestListGroups(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/groups", testGroupsJson, 2)
+
+	gs, err := client.ListAllGroups(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gs) != 2 || !GroupValueEqual(testPosts[0].Group, &gs[1]) {
+		t.Fatalf("Response not matched: %v", gs)
+	}
+}
+
+func TestGroup(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	postGroupJson := []byte(`{"name":"Dev","private":false,"url_name":"dev"}`)
+	mux.HandleFunc("/api/v2/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, postGroupJson)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(firstJson(t, testGroupsJson))
+	})
+	mux.HandleFunc("/api/v2/groups/dev", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+		case "PATCH":
+			testBody(t, r, postGroupJson)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("Unexpected method: %v", r.Method)
+		}
+		w.Write(firstJson(t, testGroupsJson))
+	})
+
+	ctx := context.Background()
+	pg := &PostGroup{Name: "Dev", UrlName: "dev"}
+	for _, f := range []func() (*Group, error){
+		func() (*Group, error) { return client.CreateGroup(ctx, pg) },
+		func() (*Group, error) { return client.GetGroup(ctx, "dev") },
+		func() (*Group, error) { return client.UpdateGroup(ctx, "dev", pg) },
+	} {
+		g, err := f()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !GroupValueEqual(testPosts[0].Group, g) {
+			t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts[0].Group, g)
+		}
+	}
+	if err := client.DeleteGroup(ctx, "dev"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGroupMembers(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/groups/dev/members", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write(testGroupMembersJson)
+		case "POST":
+			testBody(t, r, []byte(`{"identity":"yaotti"}`))
+			w.WriteHeader(http.StatusCreated)
+			w.Write(firstJson(t, testGroupMembersJson))
+		default:
+			t.Errorf("Unexpected method: %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v2/groups/dev/members/yaotti", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	ms, _, err := client.ListGroupMembers(ctx, "dev", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(testGroupMembers, ms) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testGroupMembers, ms)
+	}
+	m, err := client.AddGroupMember(ctx, "dev", "yaotti")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testGroupMembers[0], m) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testGroupMembers[0], m)
+	}
+	if err := client.RemoveGroupMember(ctx, "dev", "yaotti"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/url"
+)
+
+// ListItemsOptions is options for ListItems.
+type ListItemsOptions struct {
+	ListOptions
+	Query string
+}
+
+func (o *ListItemsOptions) values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	v := o.ListOptions.values()
+	if o.Query != "" {
+		v.Set("query", o.Query)
+	}
+	return v
+}
+
+// GetItem gets item(post) by id.
+func (c *Client) GetItem(ctx context.Context, id string) (*Post, error) {
+	p := &Post{}
+	if _, err := c.call(ctx, "GET", "items/"+url.PathEscape(id), nil, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ListItems lists items(posts) in descending order of created time.
+func (c *Client) ListItems(ctx context.Context, opts *ListItemsOptions) (Posts, *Response, error) {
+	ps := Posts{}
+	resp, err := c.call(ctx, "GET", withValues("items", opts.values()), nil, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ps, resp, nil
+}
+
+// ListAllItems lists items(posts) of all pages.
+func (c *Client) ListAllItems(ctx context.Context, opts *ListItemsOptions) (Posts, error) {
+	o := ListItemsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	all := Posts{}
+	err := allPages(&o.ListOptions, func(lo *ListOptions) (*Response, error) {
+		o.ListOptions = *lo
+		ps, resp, err := c.ListItems(ctx, &o)
+		all = append(all, ps...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// CreateItem creates new item(post).
+func (c *Client) CreateItem(ctx context.Context, item *PostItem) (*Post, error) {
+	p := &Post{}
+	if _, err := c.call(ctx, "POST", "items", item, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// String returns pointer of s, for optional fields like PatchItem.
+func String(s string) *string {
+	return &s
+}
+
+// Bool returns pointer of b, for optional fields like PatchItem.
+func Bool(b bool) *bool {
+	return &b
+}
+
+// UpdateItem updates item(post) by id, only fields set in item.
+func (c *Client) UpdateItem(ctx context.Context, id string, item *PatchItem) (*Post, error) {
+	p := &Post{}
+	if _, err := c.call(ctx, "PATCH", "items/"+url.PathEscape(id), item, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DeleteItem deletes item(post) by id.
+func (c *Client) DeleteItem(ctx context.Context, id string) error {
+	_, err := c.call(ctx, "DELETE", "items/"+url.PathEscape(id), nil, nil)
+	return err
+}
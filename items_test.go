@@ -0,0 +1,115 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetItem(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(firstJson(t, testPostsJson))
+	})
+
+	p, err := client.GetItem(context.Background(), "4bd431809afb1bb99e4f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !PostValueEqual(&testPosts[0], p) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts[0], p)
+	}
+}
+
+func TestListItems(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "page=2&per_page=10&query=tag%3ARuby"; r.URL.RawQuery != want {
+			t.Errorf("Query not matched.\nwant: %v\nhave: %v\n", want, r.URL.RawQuery)
+		}
+		w.Write(testPostsJson)
+	})
+
+	opts := &ListItemsOptions{ListOptions: ListOptions{Page: 2, PerPage: 10}, Query: "tag:Ruby"}
+	ps, _, err := client.ListItems(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 1 || !PostValueEqual(&testPosts[0], &ps[0]) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts, ps)
+	}
+}
+
+func TestCreateItem(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, testPostItemJson)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(firstJson(t, testPostsJson))
+	})
+
+	p, err := client.CreateItem(context.Background(), &testPostItem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !PostValueEqual(&testPosts[0], p) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts[0], p)
+	}
+}
+
+func TestUpdateItem(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, []byte(`{"body":"# Example","private":false,"tags":[{"name":"Ruby","versions":["0.0.1"]}],"title":"Example title"}`))
+		w.Write(firstJson(t, testPostsJson))
+	})
+
+	item := &PatchItem{
+		Body:    String(testPostItem.Body),
+		Private: Bool(false),
+		Tags:    testPostItem.Tags,
+		Title:   String(testPostItem.Title),
+	}
+	p, err := client.UpdateItem(context.Background(), "4bd431809afb1bb99e4f", item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !PostValueEqual(&testPosts[0], p) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts[0], p)
+	}
+}
+
+func TestUpdateItemPartial(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, []byte(`{"title":"New title"}`))
+		w.Write(firstJson(t, testPostsJson))
+	})
+
+	if _, err := client.UpdateItem(context.Background(), "4bd431809afb1bb99e4f", &PatchItem{Title: String("New title")}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteItem(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteItem(context.Background(), "4bd431809afb1bb99e4f"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+)
+
+// ItemIterator iterates items(posts) of paginated list api,
+// fetching next page on demand.
+//
+//	it := client.Items(opts)
+//	for it.Next(ctx) {
+//		p := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type ItemIterator struct {
+	fetch func(ctx context.Context, opts *ListOptions) (Posts, *Response, error)
+	opts  ListOptions
+	page  Posts
+	i     int
+	item  *Post
+	resp  *Response
+	done  bool
+	err   error
+}
+
+func newItemIterator(opts *ListOptions, fetch func(ctx context.Context, opts *ListOptions) (Posts, *Response, error)) *ItemIterator {
+	it := &ItemIterator{fetch: fetch}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.Page == 0 {
+		it.opts.Page = 1
+	}
+	return it
+}
+
+// Next advances to next item, and fetches next page if needed.
+// It returns false when iteration is finished or an error occurred.
+func (it *ItemIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.i >= len(it.page) {
+		if it.done {
+			it.item = nil
+			return false
+		}
+		ps, resp, err := it.fetch(ctx, &it.opts)
+		if err != nil {
+			it.err = err
+			it.item = nil
+			return false
+		}
+		it.page, it.i, it.resp = ps, 0, resp
+		if resp.NextPage == 0 || resp.NextPage <= it.opts.Page {
+			it.done = true
+		} else {
+			it.opts.Page = resp.NextPage
+		}
+	}
+	it.item = &it.page[it.i]
+	it.i++
+	return true
+}
+
+// Item returns current item, nil before Next or after iteration.
+func (it *ItemIterator) Item() *Post {
+	return it.item
+}
+
+// Response returns response of current page.
+func (it *ItemIterator) Response() *Response {
+	return it.resp
+}
+
+// Err returns error occurred in iteration.
+func (it *ItemIterator) Err() error {
+	return it.err
+}
+
+// Items returns ItemIterator over ListItems.
+func (c *Client) Items(opts *ListItemsOptions) *ItemIterator {
+	o := ListItemsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	return newItemIterator(&o.ListOptions, func(ctx context.Context, lo *ListOptions) (Posts, *Response, error) {
+		o.ListOptions = *lo
+		return c.ListItems(ctx, &o)
+	})
+}
+
+// TagItems returns ItemIterator over ListTagItems.
+func (c *Client) TagItems(tagId string, opts *ListOptions) *ItemIterator {
+	return newItemIterator(opts, func(ctx context.Context, lo *ListOptions) (Posts, *Response, error) {
+		return c.ListTagItems(ctx, tagId, lo)
+	})
+}
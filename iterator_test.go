@@ -0,0 +1,81 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestItemIterator(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	pages := 0
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		if want := "page=" + strconv.Itoa(pages) + "&query=tag%3ARuby"; r.URL.RawQuery != want {
+			t.Errorf("Query not matched.\nwant: %v\nhave: %v\n", want, r.URL.RawQuery)
+		}
+		w.Header().Set("Link", pageLink("https://qiita.com/api/v2/items", pages, 3))
+		w.Write(testPostsJson)
+	})
+
+	it := client.Items(&ListItemsOptions{Query: "tag:Ruby"})
+	n := 0
+	for it.Next(context.Background()) {
+		if !PostValueEqual(&testPosts[0], it.Item()) {
+			t.Fatalf("Item not matched.\nwant: %v\nhave: %v\n", testPosts[0], it.Item())
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || pages != 3 {
+		t.Fatalf("Count not matched.\nwant: %v\nhave: %v, %v\n", 3, n, pages)
+	}
+	if it.Item() != nil || it.Next(context.Background()) {
+		t.Fatal("Iteration expected finished.")
+	}
+}
+
+func TestItemIteratorStopEarly(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	pages := 0
+	mux.HandleFunc("/api/v2/tags/Ruby/items", func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		w.Header().Set("Link", pageLink("https://qiita.com/api/v2/tags/Ruby/items", pages, 100))
+		w.Write(testPostsJson)
+	})
+
+	it := client.TagItems("Ruby", nil)
+	for i := 0; i < 2 && it.Next(context.Background()); i++ {
+	}
+	if pages != 2 {
+		t.Fatalf("Fetched pages not matched.\nwant: %v\nhave: %v\n", 2, pages)
+	}
+}
+
+func TestItemIteratorError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write(testNotFoundJson)
+			return
+		}
+		w.Header().Set("Link", pageLink("https://qiita.com/api/v2/items", 1, 2))
+		w.Write(testPostsJson)
+	})
+
+	it := client.Items(nil)
+	n := 0
+	for it.Next(context.Background()) {
+		n++
+	}
+	if n != 1 || !IsNotFound(it.Err()) {
+		t.Fatalf("Error expected after first page: %v, %v", n, it.Err())
+	}
+}
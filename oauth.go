@@ -0,0 +1,142 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"strings"
+)
+
+// Scope is scope of access token in Qiita api.
+type Scope string
+
+// Scopes defined in Qiita api.
+const (
+	ScopeReadQiita      Scope = "read_qiita"
+	ScopeWriteQiita     Scope = "write_qiita"
+	ScopeReadQiitaTeam  Scope = "read_qiita_team"
+	ScopeWriteQiitaTeam Scope = "write_qiita_team"
+)
+
+// Valid reports whether scope is defined in Qiita api.
+func (s Scope) Valid() bool {
+	switch s {
+	case ScopeReadQiita, ScopeWriteQiita, ScopeReadQiitaTeam, ScopeWriteQiitaTeam:
+		return true
+	}
+	return false
+}
+
+// Scopes is set of Scope.
+type Scopes []Scope
+
+// ParseScopes parses space separated scopes.
+func ParseScopes(s string) Scopes {
+	ss := Scopes{}
+	for _, f := range strings.Fields(s) {
+		ss = ss.Add(Scope(f))
+	}
+	return ss
+}
+
+// Has reports whether scopes has scope.
+func (ss Scopes) Has(scope Scope) bool {
+	for _, s := range ss {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Add returns scopes with scope, without duplication.
+func (ss Scopes) Add(scope Scope) Scopes {
+	if ss.Has(scope) {
+		return ss
+	}
+	return append(ss, scope)
+}
+
+// String returns space separated scopes.
+func (ss Scopes) String() string {
+	strs := make([]string, len(ss))
+	for i, s := range ss {
+		strs[i] = string(s)
+	}
+	return strings.Join(strs, " ")
+}
+
+// OAuthConfig is config of OAuth2 application registered in Qiita.
+type OAuthConfig struct {
+	ClientId     string
+	ClientSecret string
+	Scopes       Scopes
+	// Client is used to call api, public Qiita client when nil.
+	Client *Client
+}
+
+func (o *OAuthConfig) client() *Client {
+	if o.Client == nil {
+		return NewClient("", nil)
+	}
+	return o.Client
+}
+
+// AuthorizeUrl returns url of "oauth/authorize" to redirect user to.
+// state should be random value checked on callback, see NewOAuthState.
+func (o *OAuthConfig) AuthorizeUrl(state string) string {
+	u, _ := o.client().BaseUrl.Parse("oauth/authorize")
+	v := url.Values{}
+	v.Set("client_id", o.ClientId)
+	if len(o.Scopes) > 0 {
+		v.Set("scope", o.Scopes.String())
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+// Exchange exchanges authorization code for access token.
+func (o *OAuthConfig) Exchange(ctx context.Context, code string) (*AccessToken, error) {
+	return o.client().CreateAccessToken(ctx, &PostAccessToken{
+		ClientId:     o.ClientId,
+		ClientSecret: o.ClientSecret,
+		Code:         code,
+	})
+}
+
+// Revoke revokes access token.
+func (o *OAuthConfig) Revoke(ctx context.Context, token string) error {
+	return o.client().DeleteAccessToken(ctx, token)
+}
+
+// NewOAuthState returns random state for AuthorizeUrl.
+func NewOAuthState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CreateAccessToken issues new access token.
+func (c *Client) CreateAccessToken(ctx context.Context, token *PostAccessToken) (*AccessToken, error) {
+	t := &AccessToken{}
+	if _, err := c.call(ctx, "POST", "access_tokens", token, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DeleteAccessToken revokes access token.
+func (c *Client) DeleteAccessToken(ctx context.Context, token string) error {
+	_, err := c.call(ctx, "DELETE", "access_tokens/"+url.PathEscape(token), nil, nil)
+	return err
+}
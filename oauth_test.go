@@ -0,0 +1,93 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testAccessTokenJson = []byte(`
+{
+  "client_id": "a91f0396a0968ff593eafdd194e3d17d32c41b1da7b25e873b42e9058058cd9d",
+  "scopes": [
+    "read_qiita"
+  ],
+  "token": "ea5d0a593b2655e9568f144fb1826342292f5c6b"
+}
+`)
+
+var testAccessToken = AccessToken{
+	ClientId: "a91f0396a0968ff593eafdd194e3d17d32c41b1da7b25e873b42e9058058cd9d",
+	Scopes:   Scopes{ScopeReadQiita},
+	Token:    "ea5d0a593b2655e9568f144fb1826342292f5c6b",
+}
+
+func TestScopes(t *testing.T) {
+	ss := ParseScopes("read_qiita  write_qiita read_qiita")
+	if want := (Scopes{ScopeReadQiita, ScopeWriteQiita}); !reflect.DeepEqual(want, ss) {
+		t.Fatalf("Scopes not matched.\nwant: %v\nhave: %v\n", want, ss)
+	}
+	if !ss.Has(ScopeWriteQiita) || ss.Has(ScopeReadQiitaTeam) {
+		t.Fatalf("Has not matched: %v", ss)
+	}
+	if want := "read_qiita write_qiita"; ss.String() != want {
+		t.Fatalf("String not matched.\nwant: %v\nhave: %v\n", want, ss.String())
+	}
+	if !ScopeWriteQiitaTeam.Valid() || Scope("admin").Valid() {
+		t.Fatal("Valid not matched.")
+	}
+}
+
+func TestAuthorizeUrl(t *testing.T) {
+	o := &OAuthConfig{
+		ClientId: "client",
+		Scopes:   Scopes{ScopeReadQiita, ScopeWriteQiita},
+	}
+	want := "https://qiita.com/api/v2/oauth/authorize?client_id=client&scope=read_qiita+write_qiita&state=state"
+	if u := o.AuthorizeUrl("state"); u != want {
+		t.Fatalf("Url not matched.\nwant: %v\nhave: %v\n", want, u)
+	}
+}
+
+func TestNewOAuthState(t *testing.T) {
+	s1, err := NewOAuthState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := NewOAuthState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s1) != 32 || s1 == s2 {
+		t.Fatalf("Random state expected: %v, %v", s1, s2)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, []byte(`{"client_id":"client","client_secret":"secret","code":"code"}`))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(testAccessTokenJson)
+	})
+	mux.HandleFunc("/api/v2/access_tokens/ea5d0a593b2655e9568f144fb1826342292f5c6b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	o := &OAuthConfig{ClientId: "client", ClientSecret: "secret", Client: client}
+	ctx := context.Background()
+	token, err := o.Exchange(ctx, "code")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testAccessToken, token) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testAccessToken, token)
+	}
+	if err := o.Revoke(ctx, token.Token); err != nil {
+		t.Fatal(err)
+	}
+}
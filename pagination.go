@@ -0,0 +1,103 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MaxPerPage is max value of "per_page" in Qiita api.
+const MaxPerPage = 100
+
+// ListOptions is options for paginated list api.
+// Zero value fields are not sent.
+type ListOptions struct {
+	Page    int
+	PerPage int
+}
+
+func (o *ListOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	return v
+}
+
+// withValues appends encoded query values to path.
+func withValues(path string, v url.Values) string {
+	if len(v) == 0 {
+		return path
+	}
+	return path + "?" + v.Encode()
+}
+
+// parseLink parses "Link" header like
+// <https://qiita.com/api/v2/items?page=2>; rel="next", ...
+func (r *Response) parseLink() {
+	link := r.Header.Get("Link")
+	if link == "" {
+		return
+	}
+	for _, l := range strings.Split(link, ",") {
+		parts := strings.Split(strings.TrimSpace(l), ";")
+		if len(parts) < 2 {
+			continue
+		}
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(parts[0]), "<>"))
+		if err != nil {
+			continue
+		}
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			continue
+		}
+		for _, p := range parts[1:] {
+			switch strings.TrimSpace(p) {
+			case `rel="first"`:
+				r.FirstPage = page
+			case `rel="prev"`:
+				r.PrevPage = page
+			case `rel="next"`:
+				r.NextPage = page
+			case `rel="last"`:
+				r.LastPage = page
+			}
+		}
+	}
+}
+
+// allPages calls list for each page, from opts.Page(or first page)
+// until the response has no next page. PerPage is MaxPerPage if not set.
+func allPages(opts *ListOptions, list func(opts *ListOptions) (*Response, error)) error {
+	o := ListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+	if o.PerPage == 0 {
+		o.PerPage = MaxPerPage
+	}
+	for {
+		resp, err := list(&o)
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 || resp.NextPage <= o.Page {
+			return nil
+		}
+		o.Page = resp.NextPage
+	}
+}
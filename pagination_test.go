@@ -0,0 +1,138 @@
+package qiitago
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+var testUsersJson = []byte(`
+[
+  {
+    "description": "Hello, world.",
+    "facebook_id": "yaotti",
+    "followees_count": 100,
+    "followers_count": 200,
+    "github_login_name": "yaotti",
+    "id": "yaotti",
+    "items_count": 300,
+    "linkedin_id": "yaotti",
+    "location": "Tokyo, Japan",
+    "name": "Hiroshige Umino",
+    "organization": "Increments Inc",
+    "permanent_id": 1,
+    "profile_image_url": "https://si0.twimg.com/profile_images/2309761038/1ijg13pfs0dg84sk2y0h_normal.jpeg",
+    "twitter_screen_name": "yaotti",
+    "website_url": "http://yaotti.hatenablog.com"
+  }
+]
+`)
+
+// pageLink returns "Link" header for page of last pages.
+func pageLink(base string, page, last int) string {
+	link := fmt.Sprintf(`<%s?page=1>; rel="first"`, base)
+	if page > 1 {
+		link += fmt.Sprintf(`, <%s?page=%d>; rel="prev"`, base, page-1)
+	}
+	if page < last {
+		link += fmt.Sprintf(`, <%s?page=%d>; rel="next"`, base, page+1)
+	}
+	return link + fmt.Sprintf(`, <%s?page=%d>; rel="last"`, base, last)
+}
+
+// handlePages serves body for each page of last pages.
+func handlePages(t *testing.T, mux *http.ServeMux, path string, body []byte, last int) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if r.URL.Query().Get("per_page") != "100" {
+			t.Errorf("per_page not matched: %v", r.URL.RawQuery)
+		}
+		w.Header().Set("Link", pageLink("https://qiita.com"+path, page, last))
+		w.Header().Set("Total-Count", strconv.Itoa(last))
+		w.Write(body)
+	})
+}
+
+func TestParseLink(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/users", testUsersJson, 5)
+
+	_, resp, err := client.ListUsers(context.Background(), &ListOptions{Page: 3, PerPage: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [5]int{1, 2, 4, 5, 5}
+	have := [5]int{resp.FirstPage, resp.PrevPage, resp.NextPage, resp.LastPage, resp.TotalCount}
+	if want != have {
+		t.Fatalf("Pages not matched.\nwant: %v\nhave: %v\n", want, have)
+	}
+}
+
+func TestParseLinkNone(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testUsersJson)
+	})
+
+	_, resp, err := client.ListUsers(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FirstPage != 0 || resp.PrevPage != 0 || resp.NextPage != 0 || resp.LastPage != 0 || resp.TotalCount != 0 {
+		t.Fatalf("Pages expected empty: %+v", resp)
+	}
+}
+
+func TestListAllItems(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/items", testPostsJson, 3)
+
+	ps, err := client.ListAllItems(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 3 {
+		t.Fatalf("Length not matched.\nwant: %v\nhave: %v\n", 3, len(ps))
+	}
+	for i := range ps {
+		if !PostValueEqual(&testPosts[0], &ps[i]) {
+			t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts[0], ps[i])
+		}
+	}
+}
+
+func TestListAllUsers(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/users", testUsersJson, 2)
+
+	us, err := client.ListAllUsers(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(us) != 2 || !UserValueEqual(&testPosts[0].User, &us[1]) {
+		t.Fatalf("Response not matched: %v", us)
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Link", pageLink("https://qiita.com/api/v2/users", 1, 2))
+		w.Write(testUsersJson)
+	})
+
+	if _, err := client.ListAllUsers(context.Background(), nil); err == nil {
+		t.Fatal("Error expected.")
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"strconv"
+)
+
+// Projects are available in Qiita:Team only.
+
+// ListProjects lists projects.
+func (c *Client) ListProjects(ctx context.Context, opts *ListOptions) (Projects, *Response, error) {
+	ps := Projects{}
+	resp, err := c.call(ctx, "GET", withValues("projects", opts.values()), nil, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ps, resp, nil
+}
+
+// ListAllProjects lists projects of all pages.
+func (c *Client) ListAllProjects(ctx context.Context, opts *ListOptions) (Projects, error) {
+	all := Projects{}
+	err := allPages(opts, func(o *ListOptions) (*Response, error) {
+		ps, resp, err := c.ListProjects(ctx, o)
+		all = append(all, ps...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// GetProject gets project by id.
+func (c *Client) GetProject(ctx context.Context, id int) (*Project, error) {
+	p := &Project{}
+	if _, err := c.call(ctx, "GET", "projects/"+strconv.Itoa(id), nil, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// CreateProject creates new project.
+func (c *Client) CreateProject(ctx context.Context, project *PostProject) (*Project, error) {
+	p := &Project{}
+	if _, err := c.call(ctx, "POST", "projects", project, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UpdateProject updates project by id.
+// Set Archived to archive or unarchive project.
+func (c *Client) UpdateProject(ctx context.Context, id int, project *PostProject) (*Project, error) {
+	p := &Project{}
+	if _, err := c.call(ctx, "PATCH", "projects/"+strconv.Itoa(id), project, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DeleteProject deletes project by id.
+func (c *Client) DeleteProject(ctx context.Context, id int) error {
+	_, err := c.call(ctx, "DELETE", "projects/"+strconv.Itoa(id), nil, nil)
+	return err
+}
+
+// ListProjectComments lists comments of project.
+func (c *Client) ListProjectComments(ctx context.Context, projectId int) (Comments, error) {
+	cs := Comments{}
+	if _, err := c.call(ctx, "GET", "projects/"+strconv.Itoa(projectId)+"/comments", nil, &cs); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// CreateProjectComment creates new comment on project.
+func (c *Client) CreateProjectComment(ctx context.Context, projectId int, body string) (*Comment, error) {
+	cm := &Comment{}
+	if _, err := c.call(ctx, "POST", "projects/"+strconv.Itoa(projectId)+"/comments", &PostComment{Body: body}, cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
@@ -0,0 +1,110 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestListProjects(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/projects", testProjectsJson, 2)
+
+	ps, err := client.ListAllProjects(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 2 || !ProjectValueEqual(&testProjects[0], &ps[1]) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testProjects, ps)
+	}
+}
+
+func TestGetProject(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+		case "PATCH":
+			testBody(t, r, testPostProjectJson)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("Unexpected method: %v", r.Method)
+		}
+		w.Write(firstJson(t, testProjectsJson))
+	})
+
+	ctx := context.Background()
+	p, err := client.GetProject(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ProjectValueEqual(&testProjects[0], p) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testProjects[0], p)
+	}
+	p, err = client.UpdateProject(ctx, 1, &testPostProject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ProjectValueEqual(&testProjects[0], p) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testProjects[0], p)
+	}
+	if err := client.DeleteProject(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateProject(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, testPostProjectJson)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(firstJson(t, testProjectsJson))
+	})
+
+	p, err := client.CreateProject(context.Background(), &testPostProject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ProjectValueEqual(&testProjects[0], p) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testProjects[0], p)
+	}
+}
+
+func TestProjectComments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/projects/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write([]byte("[" + string(testCommentJson) + "]"))
+		case "POST":
+			testBody(t, r, []byte(`{"body":"# Example"}`))
+			w.WriteHeader(http.StatusCreated)
+			w.Write(testCommentJson)
+		default:
+			t.Errorf("Unexpected method: %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	cs, err := client.ListProjectComments(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 1 || !CommentValueEqual(&testComment, &cs[0]) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testComment, cs)
+	}
+	c, err := client.CreateProjectComment(ctx, 1, "# Example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !CommentValueEqual(&testComment, c) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testComment, c)
+	}
+}
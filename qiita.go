@@ -21,7 +21,6 @@
 package qiitago
 
 import (
-	"reflect"
 	"time"
 )
 
@@ -35,6 +34,14 @@ type Comment struct {
 	User         User      `json:"user"`
 }
 
+// Comments is struct for array of "comment" in Qiita api.
+type Comments []Comment
+
+// PostComment is struct for POST "comment"(create new comment) in Qiita api.
+type PostComment struct {
+	Body string `json:"body"`
+}
+
 // Post is struct for "post" in Qiita api.
 type Post struct {
 	Id             string    `json:"id"`
@@ -58,6 +65,29 @@ type Post struct {
 // Posts is struct for array of post in Qiita api.
 type Posts []Post
 
+// PostItem is struct for POST "item"(create new item) in Qiita api.
+type PostItem struct {
+	Body         string   `json:"body"`
+	Coediting    bool     `json:"coediting"`
+	GroupUrlName *string  `json:"group_url_name,omitempty"`
+	Gist         bool     `json:"gist"`
+	Private      bool     `json:"private"`
+	Tags         Taggings `json:"tags"`
+	Title        string   `json:"title"`
+	Tweet        bool     `json:"tweet"`
+}
+
+// PatchItem is struct for PATCH "item"(update item) in Qiita api.
+// Only non nil fields are sent, others are left unchanged.
+type PatchItem struct {
+	Body         *string  `json:"body,omitempty"`
+	Coediting    *bool    `json:"coediting,omitempty"`
+	GroupUrlName *string  `json:"group_url_name,omitempty"`
+	Private      *bool    `json:"private,omitempty"`
+	Tags         Taggings `json:"tags,omitempty"`
+	Title        *string  `json:"title,omitempty"`
+}
+
 // User is struct for "user" in Qiita api.
 type User struct {
 	Id                string  `json:"id"`
@@ -80,6 +110,15 @@ type User struct {
 // Users is struct for array of "user" in Qiita api.
 type Users []User
 
+// Like is struct for "like" in Qiita api.
+type Like struct {
+	CreatedAt time.Time `json:"created_at"`
+	User      User      `json:"user"`
+}
+
+// Likes is struct for array of "like" in Qiita api.
+type Likes []Like
+
 // Tagging is struct for "tagging" in Qiita api.
 type Tagging struct {
 	Name     string   `json:"name"`
@@ -110,6 +149,32 @@ type Group struct {
 	UrlName   string    `json:"url_name"`
 }
 
+// Groups is struct for array of "group" in Qiita api.
+type Groups []Group
+
+// PostGroup is struct for POST "group"(create new group) in Qiita api.
+type PostGroup struct {
+	Name    string `json:"name"`
+	Private bool   `json:"private"`
+	UrlName string `json:"url_name"`
+}
+
+// GroupMember is struct for "group_member" in Qiita api.
+type GroupMember struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GroupMembers is struct for array of "group_member" in Qiita api.
+type GroupMembers []GroupMember
+
+// PostGroupMember is struct for POST "group_member"(add group member) in Qiita api.
+type PostGroupMember struct {
+	// Identity is user id or email.
+	Identity string `json:"identity"`
+}
+
 // Team is struct for "team" in Qiita api.
 type Team struct {
 	Id     string `json:"id"`
@@ -120,6 +185,30 @@ type Team struct {
 // Teams is struct for array of "team" in Qiita api.
 type Teams []Team
 
+// TeamMember is struct for "team_member" in Qiita api.
+type TeamMember struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// TeamMembers is struct for array of "team_member" in Qiita api.
+type TeamMembers []TeamMember
+
+// TeamInvitation is struct for "team_invitation" in Qiita api.
+type TeamInvitation struct {
+	Email string `json:"email"`
+	Url   string `json:"url"`
+}
+
+// TeamInvitations is struct for array of "team_invitation" in Qiita api.
+type TeamInvitations []TeamInvitation
+
+// PostTeamInvitation is struct for POST "team_invitation"(invite member) in Qiita api.
+type PostTeamInvitation struct {
+	Email string `json:"email"`
+}
+
 // Template is struct for "template" in Qiita api.
 type Template struct {
 	Id            int      `json:"id"`
@@ -187,6 +276,11 @@ type ReactionName string
 // Reactions is type for array of "reaction" in Qiita api.
 type Reactions []Reaction
 
+// PostReaction is struct for POST "reaction"(add reaction) in Qiita api.
+type PostReaction struct {
+	Name ReactionName `json:"name"`
+}
+
 // AuthenticatedUser is struct for "authenticated_user" in Qiita api.
 type AuthenticatedUser struct {
 	Id                          string  `json:"id"`
@@ -209,103 +303,51 @@ type AuthenticatedUser struct {
 	TeamOnly                    bool    `json:"team_only"`
 }
 
+// AccessToken is struct for "access_token" in Qiita api.
+type AccessToken struct {
+	ClientId string `json:"client_id"`
+	Scopes   Scopes `json:"scopes"`
+	Token    string `json:"token"`
+}
+
+// PostAccessToken is struct for POST "access_token"(issue new access token) in Qiita api.
+type PostAccessToken struct {
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Code         string `json:"code"`
+}
+
 // UserValueEqual check instance value equality between 2 Users.
 func UserValueEqual(u1 *User, u2 *User) bool {
-	return u1.Id == u2.Id &&
-		*u1.Description == *u2.Description &&
-		*u1.FacebookId == *u2.FacebookId &&
-		u1.FolloweesCount == u2.FolloweesCount &&
-		u1.FollowersCount == u2.FollowersCount &&
-		*u1.GithubLoginName == *u2.GithubLoginName &&
-		u1.ItemsCount == u2.ItemsCount &&
-		*u1.LinkedinId == *u2.LinkedinId &&
-		*u1.Location == *u2.Location &&
-		*u1.Name == *u2.Name &&
-		*u1.Organization == *u2.Organization &&
-		u1.PermanentId == u2.PermanentId &&
-		u1.ProfileImageUrl == u2.ProfileImageUrl &&
-		*u1.TwitterScreenName == *u2.TwitterScreenName &&
-		*u1.WebsiteUrl == *u2.WebsiteUrl
+	return u1.Equal(u2)
 }
 
 // GroupValueEqual check instance value equality between 2 Groups.
 func GroupValueEqual(g1 *Group, g2 *Group) bool {
-	return g1.Id == g2.Id &&
-		g1.CreatedAt.Equal(g2.CreatedAt) &&
-		g1.Name == g2.Name &&
-		g1.Private == g2.Private &&
-		g1.UpdatedAt.Equal(g2.UpdatedAt) &&
-		g1.UrlName == g2.UrlName
+	return g1.Equal(g2)
 }
 
 // PostValueEqual check instance value equality between 2 Posts.
 func PostValueEqual(p1 *Post, p2 *Post) bool {
-	return p1.Id == p2.Id &&
-		p1.RenderedBody == p2.RenderedBody &&
-		p1.Body == p2.Body &&
-		p1.Coediting == p2.Coediting &&
-		p1.CommentsCount == p2.CommentsCount &&
-		p1.CreatedAt.Equal(p2.CreatedAt) &&
-		GroupValueEqual(p1.Group, p2.Group) &&
-		p1.LikesCount == p2.LikesCount &&
-		p1.Private == p2.Private &&
-		p1.ReactionsCount == p2.ReactionsCount &&
-		reflect.DeepEqual(p1.Tags, p2.Tags) &&
-		p1.Title == p2.Title &&
-		p1.UpdatedAt.Equal(p2.UpdatedAt) &&
-		p1.Url == p2.Url &&
-		UserValueEqual(&p1.User, &p2.User) &&
-		*p1.PageViewsCount == *p2.PageViewsCount
+	return p1.Equal(p2)
 }
 
 // CommentValueEqual check instance value equality between 2 Comments.
 func CommentValueEqual(c1 *Comment, c2 *Comment) bool {
-	return c1.Id == c2.Id &&
-		c1.Body == c2.Body &&
-		c1.CreatedAt.Equal(c2.CreatedAt) &&
-		c1.RenderedBody == c2.RenderedBody &&
-		c1.UpdatedAt.Equal(c2.UpdatedAt) &&
-		UserValueEqual(&c1.User, &c2.User)
+	return c1.Equal(c2)
 }
 
 // ProjectValueEqual check instance value equality between 2 Projects.
 func ProjectValueEqual(p1 *Project, p2 *Project) bool {
-	return p1.Id == p2.Id &&
-		p1.RenderedBody == p2.RenderedBody &&
-		p1.Archived == p2.Archived &&
-		p1.Body == p2.Body &&
-		p1.CreatedAt.Equal(p2.CreatedAt) &&
-		p1.Name == p2.Name &&
-		p1.ReactionsCount == p2.ReactionsCount &&
-		p1.UpdatedAt.Equal(p2.UpdatedAt)
+	return p1.Equal(p2)
 }
 
 // ReactionValueEqual check instance value equality between 2 Reaction.
 func ReactionValueEqual(r1 *Reaction, r2 *Reaction) bool {
-	return r1.CreatedAt.Equal(r2.CreatedAt) &&
-		r1.ImageUrl == r2.ImageUrl &&
-		r1.Name == r2.Name &&
-		UserValueEqual(&r1.User, &r2.User)
+	return r1.Equal(r2)
 }
 
 // AuthenticatedUserValueEqual check instance value equality between 2 AuthenticatedUser.
 func AuthenticatedUserValueEqual(u1 *AuthenticatedUser, u2 *AuthenticatedUser) bool {
-	return u1.Id == u2.Id &&
-		*u1.Description == *u2.Description &&
-		*u1.FacebookId == *u2.FacebookId &&
-		u1.FolloweesCount == u2.FolloweesCount &&
-		u1.FollowersCount == u2.FollowersCount &&
-		*u1.GithubLoginName == *u2.GithubLoginName &&
-		u1.ItemsCount == u2.ItemsCount &&
-		*u1.LinkedinId == *u2.LinkedinId &&
-		*u1.Location == *u2.Location &&
-		*u1.Name == *u2.Name &&
-		*u1.Organization == *u2.Organization &&
-		u1.PermanentId == u2.PermanentId &&
-		u1.ProfileImageUrl == u2.ProfileImageUrl &&
-		*u1.TwitterScreenName == *u2.TwitterScreenName &&
-		*u1.WebsiteUrl == *u2.WebsiteUrl &&
-		u1.ImageMonthlyUploadLimit == u2.ImageMonthlyUploadLimit &&
-		u1.ImageMonthlyUploadRemaining == u2.ImageMonthlyUploadRemaining &&
-		u1.TeamOnly == u1.TeamOnly
+	return u1.Equal(u2)
 }
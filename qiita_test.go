@@ -202,6 +202,26 @@ var testPostProjectJson = []byte(`
 }
 `)
 
+var testPostItemJson = []byte(`
+{
+  "body": "# Example",
+  "coediting": false,
+  "group_url_name": "dev",
+  "gist": false,
+  "private": false,
+  "tags": [
+    {
+      "name": "Ruby",
+      "versions": [
+        "0.0.1"
+      ]
+    }
+  ],
+  "title": "Example title",
+  "tweet": false
+}
+`)
+
 var testExpandedTemplateJson = []byte(`
 {
   "body": "Weekly MTG on %{Year}/%{month}/%{day}",
@@ -394,6 +414,26 @@ var testPostProject = PostProject{
 	},
 }
 
+var groupUrlName = "dev"
+
+var testPostItem = PostItem{
+	Body:         "# Example",
+	Coediting:    false,
+	GroupUrlName: &groupUrlName,
+	Gist:         false,
+	Private:      false,
+	Tags: Taggings{
+		Tagging{
+			Name: "Ruby",
+			Versions: []string{
+				"0.0.1",
+			},
+		},
+	},
+	Title: "Example title",
+	Tweet: false,
+}
+
 var testExpandedTemplate = ExpandedTemplate{
 	Body: "Weekly MTG on %{Year}/%{month}/%{day}",
 	Tags: Taggings{
@@ -530,6 +570,17 @@ func TestUnmarshalPostProject(t *testing.T) {
 	}
 }
 
+func TestUnmarshalPostItem(t *testing.T) {
+	postItem := PostItem{}
+	err := json.Unmarshal(testPostItemJson, &postItem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(testPostItem, postItem) {
+		t.Fatalf("Unmarshaled not matched.\nwant: %v\nhave: %v\n", testPostItem, postItem)
+	}
+}
+
 func TestUnmarshalExpandedTemplate(t *testing.T) {
 	expandedTemplate := ExpandedTemplate{}
 	err := json.Unmarshal(testExpandedTemplateJson, &expandedTemplate)
@@ -0,0 +1,253 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fields of search query for items.
+const (
+	QueryTitle   = "title"
+	QueryBody    = "body"
+	QueryCode    = "code"
+	QueryTag     = "tag"
+	QueryUser    = "user"
+	QueryStocks  = "stocks"
+	QueryCreated = "created"
+	QueryUpdated = "updated"
+)
+
+var queryFields = map[string]bool{
+	QueryTitle:   true,
+	QueryBody:    true,
+	QueryCode:    true,
+	QueryTag:     true,
+	QueryUser:    true,
+	QueryStocks:  true,
+	QueryCreated: true,
+	QueryUpdated: true,
+}
+
+// queryOps are comparison operators, longer first for parsing.
+var queryOps = []string{">=", "<=", ">", "<"}
+
+// QueryClause is clause of search query, like "-tag:Go" or "stocks:>10".
+type QueryClause struct {
+	// Field is one of Query* fields, empty for keyword.
+	Field string
+	// Op is comparison operator ">", ">=", "<" or "<=", empty for match.
+	Op    string
+	Value string
+	// Not negates clause.
+	Not bool
+	// Or joins clause with previous one by OR instead of AND.
+	Or bool
+}
+
+// Query is builder of "query" parameter of items api.
+//
+//	q := NewQuery().Tag("Go").Or().Tag("Ruby").Not().User("yaotti")
+//	q.String() // tag:Go OR tag:Ruby -user:yaotti
+type Query struct {
+	Clauses []QueryClause
+
+	or  bool
+	not bool
+}
+
+// NewQuery returns empty Query.
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// Or makes next clause joined with OR.
+func (q *Query) Or() *Query {
+	q.or = true
+	return q
+}
+
+// Not makes next clause negated.
+func (q *Query) Not() *Query {
+	q.not = true
+	return q
+}
+
+// Add adds clause of field, op and value with pending Or and Not.
+func (q *Query) Add(field, op, value string) *Query {
+	q.Clauses = append(q.Clauses, QueryClause{
+		Field: field,
+		Op:    op,
+		Value: value,
+		Not:   q.not,
+		Or:    q.or && len(q.Clauses) > 0,
+	})
+	q.or, q.not = false, false
+	return q
+}
+
+// Keyword adds keyword matching anywhere.
+func (q *Query) Keyword(s string) *Query {
+	return q.Add("", "", s)
+}
+
+// Title adds "title:" clause.
+func (q *Query) Title(s string) *Query {
+	return q.Add(QueryTitle, "", s)
+}
+
+// Body adds "body:" clause.
+func (q *Query) Body(s string) *Query {
+	return q.Add(QueryBody, "", s)
+}
+
+// Code adds "code:" clause.
+func (q *Query) Code(s string) *Query {
+	return q.Add(QueryCode, "", s)
+}
+
+// Tag adds "tag:" clause.
+func (q *Query) Tag(name string) *Query {
+	return q.Add(QueryTag, "", name)
+}
+
+// User adds "user:" clause.
+func (q *Query) User(id string) *Query {
+	return q.Add(QueryUser, "", id)
+}
+
+// Stocks adds "stocks:" clause comparing with n by op.
+func (q *Query) Stocks(op string, n int) *Query {
+	return q.Add(QueryStocks, op, strconv.Itoa(n))
+}
+
+// Created adds "created:" clause comparing date of t by op.
+func (q *Query) Created(op string, t time.Time) *Query {
+	return q.Add(QueryCreated, op, t.Format("2006-01-02"))
+}
+
+// Updated adds "updated:" clause comparing date of t by op.
+func (q *Query) Updated(op string, t time.Time) *Query {
+	return q.Add(QueryUpdated, op, t.Format("2006-01-02"))
+}
+
+// quoteQueryValue quotes value containing space, quote,
+// or being confused with operator.
+func quoteQueryValue(v string) string {
+	if v != "" && v != "OR" && !strings.ContainsAny(v[:1], "-<>") && !strings.ContainsAny(v, " \t\n\":\\") {
+		return v
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+}
+
+// String returns clause in query syntax.
+func (c QueryClause) String() string {
+	s := ""
+	if c.Not {
+		s = "-"
+	}
+	if c.Field != "" {
+		s += c.Field + ":" + c.Op
+	}
+	return s + quoteQueryValue(c.Value)
+}
+
+// String returns query in query syntax.
+func (q *Query) String() string {
+	strs := []string{}
+	for _, c := range q.Clauses {
+		if c.Or {
+			strs = append(strs, "OR")
+		}
+		strs = append(strs, c.String())
+	}
+	return strings.Join(strs, " ")
+}
+
+// queryToken is token of query split by spaces.
+// head is raw part before quote, and tail is unquoted rest.
+type queryToken struct {
+	head   string
+	tail   string
+	quoted bool
+}
+
+// splitQuery splits query by spaces outside quotes.
+func splitQuery(s string) ([]queryToken, error) {
+	tokens := []queryToken{}
+	var head, tail bytes.Buffer
+	inToken, inQuote, quoted := false, false, false
+	flush := func() {
+		if inToken {
+			tokens = append(tokens, queryToken{head.String(), tail.String(), quoted})
+		}
+		head.Reset()
+		tail.Reset()
+		inToken, quoted = false, false
+	}
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case inQuote && ch == '\\' && i+1 < len(s):
+			i++
+			tail.WriteByte(s[i])
+		case inQuote && ch == '"':
+			inQuote = false
+		case inQuote:
+			tail.WriteByte(ch)
+		case ch == '"':
+			inQuote, inToken, quoted = true, true, true
+		case ch == ' ' || ch == '\t' || ch == '\n':
+			flush()
+		case quoted:
+			tail.WriteByte(ch)
+		default:
+			inToken = true
+			head.WriteByte(ch)
+		}
+	}
+	if inQuote {
+		return nil, errors.New("qiitago: unterminated quote in query")
+	}
+	flush()
+	return tokens, nil
+}
+
+// ParseQuery parses query syntax into Query.
+// Negation, field and operator are only read outside quotes.
+func ParseQuery(s string) (*Query, error) {
+	tokens, err := splitQuery(s)
+	if err != nil {
+		return nil, err
+	}
+	q := NewQuery()
+	for _, t := range tokens {
+		head := t.head
+		if head == "OR" && !t.quoted {
+			q.Or()
+			continue
+		}
+		if strings.HasPrefix(head, "-") && (len(head) > 1 || t.quoted) {
+			q.Not()
+			head = head[1:]
+		}
+		field, op := "", ""
+		if j := strings.Index(head, ":"); j > 0 && queryFields[head[:j]] {
+			field, head = head[:j], head[j+1:]
+			for _, o := range queryOps {
+				if strings.HasPrefix(head, o) {
+					op, head = o, head[len(o):]
+					break
+				}
+			}
+		}
+		q.Add(field, op, head+t.tail)
+	}
+	return q, nil
+}
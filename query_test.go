@@ -0,0 +1,62 @@
+package qiitago
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQueryString(t *testing.T) {
+	tests := []struct {
+		q    *Query
+		want string
+	}{
+		{NewQuery().Tag("Go").User("yaotti"), "tag:Go user:yaotti"},
+		{NewQuery().Tag("Go").Or().Tag("Ruby"), "tag:Go OR tag:Ruby"},
+		{NewQuery().Not().Tag("Java").Keyword("docker"), "-tag:Java docker"},
+		{NewQuery().Title("hello world").Body(`say "hi"`), `title:"hello world" body:"say \"hi\""`},
+		{NewQuery().Created(">", time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)).Stocks(">=", 10), "created:>2018-01-01 stocks:>=10"},
+		{NewQuery().Keyword("OR").Keyword("-x").Keyword("a:b"), `"OR" "-x" "a:b"`},
+		{NewQuery().Or().Tag("Go"), "tag:Go"},
+	}
+	for _, tt := range tests {
+		if s := tt.q.String(); s != tt.want {
+			t.Errorf("Query not matched.\nwant: %v\nhave: %v\n", tt.want, s)
+		}
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	q, err := ParseQuery(`tag:Go OR -tag:"Visual Studio" user:yaotti stocks:>10 "-x" http://example.com`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []QueryClause{
+		{Field: "tag", Value: "Go"},
+		{Field: "tag", Value: "Visual Studio", Not: true, Or: true},
+		{Field: "user", Value: "yaotti"},
+		{Field: "stocks", Op: ">", Value: "10"},
+		{Value: "-x"},
+		{Value: "http://example.com"},
+	}
+	if !reflect.DeepEqual(want, q.Clauses) {
+		t.Fatalf("Clauses not matched.\nwant: %v\nhave: %v\n", want, q.Clauses)
+	}
+}
+
+func TestParseQueryRoundTrip(t *testing.T) {
+	q := NewQuery().Tag("C++").Tag(">x").Tag("<=y").Or().Not().Title(`a "b" c`).Keyword("OR").Keyword(`back\slash`).Updated("<=", time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC))
+	p, err := ParseQuery(q.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(q.Clauses, p.Clauses) {
+		t.Fatalf("Clauses not matched.\nwant: %v\nhave: %v\n", q.Clauses, p.Clauses)
+	}
+}
+
+func TestParseQueryError(t *testing.T) {
+	if _, err := ParseQuery(`title:"unterminated`); err == nil {
+		t.Fatal("Error expected.")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit is rate limit status parsed from
+// "Rate-Limit", "Rate-Remaining" and "Rate-Reset" headers.
+// Qiita allows 1000 requests per hour for authenticated user,
+// and 60 requests per hour for others.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// parseRateLimit parses rate limit headers, ok is false
+// when headers are not available.
+func parseRateLimit(h http.Header) (rl RateLimit, ok bool) {
+	limit, err := strconv.Atoi(h.Get("Rate-Limit"))
+	if err != nil {
+		return rl, false
+	}
+	rl.Limit = limit
+	rl.Remaining, _ = strconv.Atoi(h.Get("Rate-Remaining"))
+	if reset, err := strconv.ParseInt(h.Get("Rate-Reset"), 10, 64); err == nil {
+		rl.Reset = time.Unix(reset, 0)
+	}
+	return rl, true
+}
+
+// rateLimiter keeps last rate limit status.
+type rateLimiter struct {
+	mu        sync.Mutex
+	rateLimit RateLimit
+}
+
+func (l *rateLimiter) get() RateLimit {
+	if l == nil {
+		return RateLimit{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rateLimit
+}
+
+func (l *rateLimiter) set(rl RateLimit) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rateLimit = rl
+}
+
+// wait blocks until reset, when no request remains.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	rl := l.get()
+	if rl.Limit == 0 || rl.Remaining > 0 {
+		return nil
+	}
+	return sleep(ctx, rl.Reset.Sub(timeNow()))
+}
+
+// timeNow and sleep are replaced in tests.
+var timeNow = time.Now
+
+var sleep = func(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// RateLimit returns rate limit status of last response.
+func (c *Client) RateLimit() RateLimit {
+	return c.rate.get()
+}
@@ -0,0 +1,87 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeClock replaces timeNow and sleep, and returns restore func.
+func fakeClock(now time.Time, slept *time.Duration) func() {
+	origNow, origSleep := timeNow, sleep
+	timeNow = func() time.Time { return now }
+	sleep = func(ctx context.Context, d time.Duration) error {
+		*slept += d
+		return ctx.Err()
+	}
+	return func() {
+		timeNow, sleep = origNow, origSleep
+	}
+}
+
+func handleRateLimit(mux *http.ServeMux, remaining int, reset time.Time) {
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Rate-Limit", "1000")
+		w.Header().Set("Rate-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("Rate-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Write(testPostsJson)
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	reset := time.Date(2000, 1, 1, 1, 0, 0, 0, time.UTC)
+	handleRateLimit(mux, 999, reset)
+
+	_, resp, err := client.ListItems(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := RateLimit{Limit: 1000, Remaining: 999, Reset: reset}
+	if resp.RateLimit.Limit != want.Limit || resp.RateLimit.Remaining != want.Remaining || !resp.RateLimit.Reset.Equal(want.Reset) {
+		t.Fatalf("RateLimit not matched.\nwant: %v\nhave: %v\n", want, resp.RateLimit)
+	}
+	if rl := client.RateLimit(); rl.Remaining != want.Remaining || !rl.Reset.Equal(want.Reset) {
+		t.Fatalf("Client RateLimit not matched.\nwant: %v\nhave: %v\n", want, rl)
+	}
+}
+
+func TestWaitRateLimit(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept time.Duration
+	defer fakeClock(now, &slept)()
+	handleRateLimit(mux, 0, now.Add(10*time.Minute))
+
+	client.WaitRateLimit = true
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.ListItems(context.Background(), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if slept != 10*time.Minute {
+		t.Fatalf("Slept not matched.\nwant: %v\nhave: %v\n", 10*time.Minute, slept)
+	}
+}
+
+func TestWaitRateLimitDisabled(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept time.Duration
+	defer fakeClock(now, &slept)()
+	handleRateLimit(mux, 0, now.Add(10*time.Minute))
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.ListItems(context.Background(), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if slept != 0 {
+		t.Fatalf("Slept not expected: %v", slept)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Known reaction names in Qiita api.
+const (
+	ReactionPlusOne    ReactionName = "+1"
+	ReactionMinusOne   ReactionName = "-1"
+	ReactionSmile      ReactionName = "smile"
+	ReactionLaughing   ReactionName = "laughing"
+	ReactionJoy        ReactionName = "joy"
+	ReactionHeart      ReactionName = "heart"
+	ReactionTada       ReactionName = "tada"
+	ReactionClap       ReactionName = "clap"
+	ReactionPray       ReactionName = "pray"
+	ReactionBow        ReactionName = "bow"
+	ReactionOkHand     ReactionName = "ok_hand"
+	ReactionMuscle     ReactionName = "muscle"
+	ReactionEyes       ReactionName = "eyes"
+	ReactionRocket     ReactionName = "rocket"
+	ReactionHundred    ReactionName = "100"
+	ReactionConfused   ReactionName = "confused"
+	ReactionSob        ReactionName = "sob"
+	ReactionThinking   ReactionName = "thinking_face"
+	ReactionWhiteCheck ReactionName = "white_check_mark"
+)
+
+// ReactionEmojiBaseUrl is base url of reaction images.
+const ReactionEmojiBaseUrl = "https://cdn.qiita.com/emoji/twemoji/unicode/"
+
+// ReactionInfo is metadata of reaction name.
+type ReactionInfo struct {
+	Name ReactionName
+	// Emoji is Unicode emoji, empty for custom image reaction.
+	Emoji string
+	// ImageUrl is url of reaction image.
+	ImageUrl string
+}
+
+// EmojiImageUrl returns Qiita image url of Unicode emoji,
+// like "https://cdn.qiita.com/emoji/twemoji/unicode/1f44d.png".
+func EmojiImageUrl(emoji string) string {
+	codes := []string{}
+	for _, r := range emoji {
+		if r == 0xfe0f {
+			continue
+		}
+		codes = append(codes, fmt.Sprintf("%x", r))
+	}
+	return ReactionEmojiBaseUrl + strings.Join(codes, "-") + ".png"
+}
+
+// emojiKey strips variation selector, to match emoji with or without it.
+func emojiKey(emoji string) string {
+	return strings.Replace(emoji, "\ufe0f", "", -1)
+}
+
+// ReactionRegistry holds known reactions, including custom reactions
+// of Qiita:Team. It is safe for concurrent use.
+type ReactionRegistry struct {
+	mu      sync.RWMutex
+	byName  map[ReactionName]ReactionInfo
+	byEmoji map[string]ReactionName
+}
+
+// defaultReactionEmojis is Unicode emoji of known reaction names.
+var defaultReactionEmojis = map[ReactionName]string{
+	ReactionPlusOne:    "\U0001f44d",
+	ReactionMinusOne:   "\U0001f44e",
+	ReactionSmile:      "\U0001f604",
+	ReactionLaughing:   "\U0001f606",
+	ReactionJoy:        "\U0001f602",
+	ReactionHeart:      "❤️",
+	ReactionTada:       "\U0001f389",
+	ReactionClap:       "\U0001f44f",
+	ReactionPray:       "\U0001f64f",
+	ReactionBow:        "\U0001f647",
+	ReactionOkHand:     "\U0001f44c",
+	ReactionMuscle:     "\U0001f4aa",
+	ReactionEyes:       "\U0001f440",
+	ReactionRocket:     "\U0001f680",
+	ReactionHundred:    "\U0001f4af",
+	ReactionConfused:   "\U0001f615",
+	ReactionSob:        "\U0001f62d",
+	ReactionThinking:   "\U0001f914",
+	ReactionWhiteCheck: "✅",
+}
+
+// NewReactionRegistry returns registry of known reactions in Qiita api.
+func NewReactionRegistry() *ReactionRegistry {
+	r := &ReactionRegistry{
+		byName:  map[ReactionName]ReactionInfo{},
+		byEmoji: map[string]ReactionName{},
+	}
+	for name, emoji := range defaultReactionEmojis {
+		r.Register(ReactionInfo{Name: name, Emoji: emoji})
+	}
+	return r
+}
+
+// DefaultReactionRegistry is registry used by methods of ReactionName.
+// Registry of Client is separate from it, see Client.Reactions.
+var DefaultReactionRegistry = NewReactionRegistry()
+
+// Register registers reaction, such as custom reaction of Qiita:Team,
+// overwriting registered one of same name.
+// ImageUrl is filled from Emoji when empty.
+func (r *ReactionRegistry) Register(info ReactionInfo) {
+	if info.ImageUrl == "" && info.Emoji != "" {
+		info.ImageUrl = EmojiImageUrl(info.Emoji)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if old, ok := r.byName[info.Name]; ok && old.Emoji != "" {
+		delete(r.byEmoji, emojiKey(old.Emoji))
+	}
+	r.byName[info.Name] = info
+	if info.Emoji != "" {
+		r.byEmoji[emojiKey(info.Emoji)] = info.Name
+	}
+}
+
+// Lookup returns registered ReactionInfo of name.
+func (r *ReactionRegistry) Lookup(name ReactionName) (ReactionInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	info, ok := r.byName[name]
+	return info, ok
+}
+
+// NameFromEmoji returns registered name of Unicode emoji.
+func (r *ReactionRegistry) NameFromEmoji(emoji string) (ReactionName, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name, ok := r.byEmoji[emojiKey(emoji)]
+	return name, ok
+}
+
+// Reactions returns all registered reactions sorted by name.
+func (r *ReactionRegistry) Reactions() []ReactionInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	infos := make([]ReactionInfo, 0, len(r.byName))
+	for _, info := range r.byName {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// Valid reports whether reaction name is registered.
+func (r *ReactionRegistry) Valid(name ReactionName) bool {
+	_, ok := r.Lookup(name)
+	return ok
+}
+
+// RegisterReaction registers reaction to DefaultReactionRegistry.
+func RegisterReaction(info ReactionInfo) {
+	DefaultReactionRegistry.Register(info)
+}
+
+// LookupReaction returns ReactionInfo of name in DefaultReactionRegistry.
+func LookupReaction(name ReactionName) (ReactionInfo, bool) {
+	return DefaultReactionRegistry.Lookup(name)
+}
+
+// ReactionNameFromEmoji returns name of Unicode emoji in DefaultReactionRegistry.
+func ReactionNameFromEmoji(emoji string) (ReactionName, bool) {
+	return DefaultReactionRegistry.NameFromEmoji(emoji)
+}
+
+// RegisteredReactions returns all reactions in DefaultReactionRegistry sorted by name.
+func RegisteredReactions() []ReactionInfo {
+	return DefaultReactionRegistry.Reactions()
+}
+
+// Valid reports whether reaction name is registered in DefaultReactionRegistry.
+func (n ReactionName) Valid() bool {
+	_, ok := LookupReaction(n)
+	return ok
+}
+
+// Emoji returns Unicode emoji of reaction name, empty when unknown.
+func (n ReactionName) Emoji() string {
+	info, _ := LookupReaction(n)
+	return info.Emoji
+}
+
+// ImageUrl returns image url of reaction name, empty when unknown.
+func (n ReactionName) ImageUrl() string {
+	info, _ := LookupReaction(n)
+	return info.ImageUrl
+}
+
+// Shortcode returns reaction name as emoji shortcode like ":+1:",
+// which is compatible with Slack.
+func (n ReactionName) Shortcode() string {
+	return ":" + string(n) + ":"
+}
+
+// ReactionNameFromShortcode returns reaction name of emoji shortcode.
+func ReactionNameFromShortcode(code string) ReactionName {
+	return ReactionName(strings.TrimSuffix(strings.TrimPrefix(code, ":"), ":"))
+}
@@ -0,0 +1,57 @@
+package qiitago
+
+import (
+	"testing"
+)
+
+func TestReactionName(t *testing.T) {
+	if !ReactionPlusOne.Valid() || ReactionName("unknown").Valid() {
+		t.Fatal("Valid not matched.")
+	}
+	if want := testReactions[0].ImageUrl; testReactions[0].Name.ImageUrl() != want {
+		t.Fatalf("ImageUrl not matched.\nwant: %v\nhave: %v\n", want, testReactions[0].Name.ImageUrl())
+	}
+	if want := "\U0001f44d"; ReactionPlusOne.Emoji() != want {
+		t.Fatalf("Emoji not matched.\nwant: %v\nhave: %v\n", want, ReactionPlusOne.Emoji())
+	}
+	if want := ReactionEmojiBaseUrl + "2764.png"; ReactionHeart.ImageUrl() != want {
+		t.Fatalf("ImageUrl not matched.\nwant: %v\nhave: %v\n", want, ReactionHeart.ImageUrl())
+	}
+	if name, ok := ReactionNameFromEmoji("\U0001f389"); !ok || name != ReactionTada {
+		t.Fatalf("Name not matched: %v, %v", name, ok)
+	}
+	if name, ok := ReactionNameFromEmoji("\u2764"); !ok || name != ReactionHeart {
+		t.Fatalf("Name not matched: %v, %v", name, ok)
+	}
+	if ReactionPlusOne.Shortcode() != ":+1:" || ReactionNameFromShortcode(":tada:") != ReactionTada {
+		t.Fatal("Shortcode not matched.")
+	}
+}
+
+func TestReactionRegistry(t *testing.T) {
+	r := NewReactionRegistry()
+	custom := ReactionInfo{Name: "kobiro", ImageUrl: "https://example.com/kobiro.png"}
+	r.Register(custom)
+	if info, ok := r.Lookup("kobiro"); !ok || info != custom {
+		t.Fatalf("Registered not matched.\nwant: %v\nhave: %v\n", custom, info)
+	}
+	if !r.Valid("kobiro") || !r.Valid(ReactionPlusOne) {
+		t.Fatal("Custom and default reactions expected valid.")
+	}
+	if ReactionName("kobiro").Valid() {
+		t.Fatal("Custom reaction registered to default registry.")
+	}
+	r.Register(ReactionInfo{Name: "party", Emoji: "\U0001f389"})
+	if name, ok := r.NameFromEmoji("\U0001f389"); !ok || name != "party" {
+		t.Fatalf("Name not matched: %v, %v", name, ok)
+	}
+	infos := r.Reactions()
+	if len(infos) != len(RegisteredReactions())+2 {
+		t.Fatalf("Reactions not matched: %v", infos)
+	}
+	for i := 1; i < len(infos); i++ {
+		if infos[i-1].Name >= infos[i].Name {
+			t.Fatalf("Not sorted: %v", infos)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Reactions are available in Qiita:Team only.
+// Added reaction name must be registered in Client.Reactions.
+
+func (c *Client) listReactions(ctx context.Context, path string) (Reactions, error) {
+	rs := Reactions{}
+	if _, err := c.call(ctx, "GET", path+"/reactions", nil, &rs); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+func (c *Client) addReaction(ctx context.Context, path string, name ReactionName) (*Reaction, error) {
+	if c.Reactions != nil && !c.Reactions.Valid(name) {
+		return nil, &ValidationError{Problems: []string{fmt.Sprintf("reaction %q is not registered", name)}}
+	}
+	r := &Reaction{}
+	if _, err := c.call(ctx, "POST", path+"/reactions", &PostReaction{Name: name}, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) removeReaction(ctx context.Context, path string, name ReactionName) (*Reaction, error) {
+	r := &Reaction{}
+	if _, err := c.call(ctx, "DELETE", path+"/reactions/"+url.PathEscape(string(name)), nil, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListItemReactions lists reactions of item.
+func (c *Client) ListItemReactions(ctx context.Context, itemId string) (Reactions, error) {
+	return c.listReactions(ctx, "items/"+url.PathEscape(itemId))
+}
+
+// AddItemReaction adds reaction to item.
+func (c *Client) AddItemReaction(ctx context.Context, itemId string, name ReactionName) (*Reaction, error) {
+	return c.addReaction(ctx, "items/"+url.PathEscape(itemId), name)
+}
+
+// RemoveItemReaction removes reaction from item, and returns removed one.
+func (c *Client) RemoveItemReaction(ctx context.Context, itemId string, name ReactionName) (*Reaction, error) {
+	return c.removeReaction(ctx, "items/"+url.PathEscape(itemId), name)
+}
+
+// ListCommentReactions lists reactions of comment.
+func (c *Client) ListCommentReactions(ctx context.Context, commentId string) (Reactions, error) {
+	return c.listReactions(ctx, "comments/"+url.PathEscape(commentId))
+}
+
+// AddCommentReaction adds reaction to comment.
+func (c *Client) AddCommentReaction(ctx context.Context, commentId string, name ReactionName) (*Reaction, error) {
+	return c.addReaction(ctx, "comments/"+url.PathEscape(commentId), name)
+}
+
+// RemoveCommentReaction removes reaction from comment, and returns removed one.
+func (c *Client) RemoveCommentReaction(ctx context.Context, commentId string, name ReactionName) (*Reaction, error) {
+	return c.removeReaction(ctx, "comments/"+url.PathEscape(commentId), name)
+}
+
+// ListProjectReactions lists reactions of project.
+func (c *Client) ListProjectReactions(ctx context.Context, projectId int) (Reactions, error) {
+	return c.listReactions(ctx, "projects/"+strconv.Itoa(projectId))
+}
+
+// AddProjectReaction adds reaction to project.
+func (c *Client) AddProjectReaction(ctx context.Context, projectId int, name ReactionName) (*Reaction, error) {
+	return c.addReaction(ctx, "projects/"+strconv.Itoa(projectId), name)
+}
+
+// RemoveProjectReaction removes reaction from project, and returns removed one.
+func (c *Client) RemoveProjectReaction(ctx context.Context, projectId int, name ReactionName) (*Reaction, error) {
+	return c.removeReaction(ctx, "projects/"+strconv.Itoa(projectId), name)
+}
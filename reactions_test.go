@@ -0,0 +1,99 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// handleReactions serves reaction api under path.
+func handleReactions(t *testing.T, mux *http.ServeMux, path string) {
+	mux.HandleFunc(path+"/reactions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write(testReactionsJson)
+		case "POST":
+			testBody(t, r, []byte(`{"name":"+1"}`))
+			w.WriteHeader(http.StatusCreated)
+			w.Write(firstJson(t, testReactionsJson))
+		default:
+			t.Errorf("Unexpected method: %v", r.Method)
+		}
+	})
+	mux.HandleFunc(path+"/reactions/+1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.Write(firstJson(t, testReactionsJson))
+	})
+}
+
+func TestReactions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handleReactions(t, mux, "/api/v2/items/4bd431809afb1bb99e4f")
+	handleReactions(t, mux, "/api/v2/comments/3391f50c35f953abfc4f")
+	handleReactions(t, mux, "/api/v2/projects/1")
+
+	ctx := context.Background()
+	tests := []struct {
+		list   func() (Reactions, error)
+		add    func() (*Reaction, error)
+		remove func() (*Reaction, error)
+	}{
+		{
+			func() (Reactions, error) { return client.ListItemReactions(ctx, "4bd431809afb1bb99e4f") },
+			func() (*Reaction, error) { return client.AddItemReaction(ctx, "4bd431809afb1bb99e4f", "+1") },
+			func() (*Reaction, error) { return client.RemoveItemReaction(ctx, "4bd431809afb1bb99e4f", "+1") },
+		},
+		{
+			func() (Reactions, error) { return client.ListCommentReactions(ctx, "3391f50c35f953abfc4f") },
+			func() (*Reaction, error) { return client.AddCommentReaction(ctx, "3391f50c35f953abfc4f", "+1") },
+			func() (*Reaction, error) { return client.RemoveCommentReaction(ctx, "3391f50c35f953abfc4f", "+1") },
+		},
+		{
+			func() (Reactions, error) { return client.ListProjectReactions(ctx, 1) },
+			func() (*Reaction, error) { return client.AddProjectReaction(ctx, 1, "+1") },
+			func() (*Reaction, error) { return client.RemoveProjectReaction(ctx, 1, "+1") },
+		},
+	}
+	for _, tt := range tests {
+		rs, err := tt.list()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rs) != 1 || !ReactionValueEqual(&testReactions[0], &rs[0]) {
+			t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testReactions, rs)
+		}
+		for _, f := range []func() (*Reaction, error){tt.add, tt.remove} {
+			r, err := f()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ReactionValueEqual(&testReactions[0], r) {
+				t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testReactions[0], r)
+			}
+		}
+	}
+}
+
+func TestAddUnregisteredReaction(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f/reactions", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, []byte(`{"name":"kobiro"}`))
+		w.WriteHeader(http.StatusCreated)
+		w.Write(firstJson(t, testReactionsJson))
+	})
+
+	ctx := context.Background()
+	_, err := client.AddItemReaction(ctx, "4bd431809afb1bb99e4f", "kobiro")
+	if _, ok := err.(*ValidationError); !ok {
+		t.Fatalf("ValidationError expected: %v", err)
+	}
+	client.Reactions.Register(ReactionInfo{Name: "kobiro", ImageUrl: "https://example.com/kobiro.png"})
+	if _, err := client.AddItemReaction(ctx, "4bd431809afb1bb99e4f", "kobiro"); err != nil {
+		t.Fatal(err)
+	}
+	if NewClient("", nil).Reactions.Valid("kobiro") || ReactionName("kobiro").Valid() {
+		t.Fatal("Registry shared among clients.")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy is policy to retry failed requests with exponential backoff.
+// Network errors and responses with RetryableStatus are retried.
+// Rate limited responses are retried after "Rate-Reset",
+// and "Retry-After" header is honoured over backoff.
+type RetryPolicy struct {
+	// MaxAttempts is max number of requests including first one.
+	MaxAttempts int
+	// BaseDelay is delay before first retry, doubled for each retry.
+	BaseDelay time.Duration
+	// MaxDelay is max of backoff delay, no limit when 0.
+	MaxDelay time.Duration
+	// Jitter is fraction of delay randomly subtracted, in [0, 1].
+	Jitter float64
+	// RetryableStatus is http status codes to retry.
+	RetryableStatus []int
+	// RetryNonIdempotent allows retry of POST and PATCH.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns RetryPolicy with 3 attempts,
+// retrying 429 and 5xx gateway errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.5,
+		RetryableStatus: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// idempotent reports whether method is idempotent.
+func idempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// retry reports whether request should be retried after attempt,
+// and delay before retry.
+func (p *RetryPolicy) retry(req *http.Request, resp *Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || err == nil || attempt >= p.MaxAttempts || req.Context().Err() != nil {
+		return 0, false
+	}
+	if !p.RetryNonIdempotent && !idempotent(req.Method) {
+		return 0, false
+	}
+	if resp == nil {
+		return p.backoff(attempt), true
+	}
+	if _, ok := err.(*APIError); !ok {
+		return 0, false
+	}
+	if IsRateLimited(err) && !resp.RateLimit.Reset.IsZero() {
+		return resp.RateLimit.Reset.Sub(timeNow()), true
+	}
+	for _, s := range p.RetryableStatus {
+		if resp.StatusCode == s {
+			if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+				return d, true
+			}
+			return p.backoff(attempt), true
+		}
+	}
+	return 0, false
+}
+
+// backoff returns delay before retry after attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay == 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+	return d
+}
+
+// retryAfter parses "Retry-After" header of seconds or http date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(timeNow()), true
+	}
+	return 0, false
+}
+
+// rewind returns request to resend, with body reset.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.WithContext(req.Context())
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
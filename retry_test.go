@@ -0,0 +1,139 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseDelay = time.Second
+	p.Jitter = 0
+	return p
+}
+
+func TestRetry(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	var slept time.Duration
+	defer fakeClock(time.Now(), &slept)()
+	n := 0
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		n++
+		if n < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write(testPostsJson)
+	})
+
+	client.Retry = testRetryPolicy()
+	if _, _, err := client.ListItems(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || slept != 3*time.Second {
+		t.Fatalf("Retry not matched.\nwant: %v, %v\nhave: %v, %v\n", 3, 3*time.Second, n, slept)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	var slept time.Duration
+	defer fakeClock(time.Now(), &slept)()
+	mux.HandleFunc("/api/v2/items/bad", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client.Retry = testRetryPolicy()
+	_, err := client.GetItem(context.Background(), "bad")
+	e, ok := err.(*APIError)
+	if !ok || e.StatusCode != http.StatusServiceUnavailable || e.Attempts != 3 {
+		t.Fatalf("APIError after 3 attempts expected, but %v", err)
+	}
+	if slept != 10*time.Second {
+		t.Fatalf("Slept not matched.\nwant: %v\nhave: %v\n", 10*time.Second, slept)
+	}
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept time.Duration
+	defer fakeClock(now, &slept)()
+	n := 0
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		n++
+		w.Header().Set("Rate-Limit", "60")
+		if n == 1 {
+			w.Header().Set("Rate-Remaining", "0")
+			w.Header().Set("Rate-Reset", strconv.FormatInt(now.Add(time.Minute).Unix(), 10))
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message":"Rate limit exceeded","type":"rate_limit_exceeded"}`))
+			return
+		}
+		w.Write(testPostsJson)
+	})
+
+	client.Retry = testRetryPolicy()
+	if _, _, err := client.ListItems(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if slept != time.Minute {
+		t.Fatalf("Slept not matched.\nwant: %v\nhave: %v\n", time.Minute, slept)
+	}
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	var slept time.Duration
+	defer fakeClock(time.Now(), &slept)()
+	n := 0
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		n++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	client.Retry = testRetryPolicy()
+	req, err := client.NewRequest(context.Background(), "POST", "items", &testPostTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, nil); err == nil {
+		t.Fatal("Error expected.")
+	}
+	if n != 1 {
+		t.Fatalf("POST retried: %v", n)
+	}
+
+	client.Retry.RetryNonIdempotent = true
+	n = 0
+	if _, err := client.Do(req, nil); err == nil {
+		t.Fatal("Error expected.")
+	}
+	if n != 3 {
+		t.Fatalf("POST not retried: %v", n)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if d := p.backoff(i + 1); d != w {
+			t.Errorf("Backoff not matched.\nwant: %v\nhave: %v\n", w, d)
+		}
+	}
+	p.Jitter = 0.5
+	for i := 1; i < 10; i++ {
+		if d := p.backoff(3); d < 2*time.Second || d > 4*time.Second {
+			t.Errorf("Backoff with jitter out of range: %v", d)
+		}
+	}
+}
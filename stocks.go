@@ -0,0 +1,63 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/url"
+)
+
+// StockItem stocks item.
+func (c *Client) StockItem(ctx context.Context, itemId string) error {
+	_, err := c.call(ctx, "PUT", "items/"+url.PathEscape(itemId)+"/stock", nil, nil)
+	return err
+}
+
+// UnstockItem unstocks item.
+func (c *Client) UnstockItem(ctx context.Context, itemId string) error {
+	_, err := c.call(ctx, "DELETE", "items/"+url.PathEscape(itemId)+"/stock", nil, nil)
+	return err
+}
+
+// IsStocked reports whether item is stocked by authenticated user.
+func (c *Client) IsStocked(ctx context.Context, itemId string) (bool, error) {
+	return c.check(ctx, "items/"+url.PathEscape(itemId)+"/stock")
+}
+
+// ListStockers lists users who stocked item, in descending order of stocked time.
+func (c *Client) ListStockers(ctx context.Context, itemId string, opts *ListOptions) (Users, *Response, error) {
+	us := Users{}
+	resp, err := c.call(ctx, "GET", withValues("items/"+url.PathEscape(itemId)+"/stockers", opts.values()), nil, &us)
+	if err != nil {
+		return nil, resp, err
+	}
+	return us, resp, nil
+}
+
+// ListUserStocks lists items stocked by user, in descending order of stocked time.
+func (c *Client) ListUserStocks(ctx context.Context, userId string, opts *ListOptions) (Posts, *Response, error) {
+	ps := Posts{}
+	resp, err := c.call(ctx, "GET", withValues("users/"+url.PathEscape(userId)+"/stocks", opts.values()), nil, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ps, resp, nil
+}
+
+// UserStocks returns ItemIterator over ListUserStocks.
+func (c *Client) UserStocks(userId string, opts *ListOptions) *ItemIterator {
+	return newItemIterator(opts, func(ctx context.Context, lo *ListOptions) (Posts, *Response, error) {
+		return c.ListUserStocks(ctx, userId, lo)
+	})
+}
+
+// ListItemLikes lists likes of item, in descending order of liked time.
+func (c *Client) ListItemLikes(ctx context.Context, itemId string) (Likes, error) {
+	ls := Likes{}
+	if _, err := c.call(ctx, "GET", "items/"+url.PathEscape(itemId)+"/likes", nil, &ls); err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
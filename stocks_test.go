@@ -0,0 +1,138 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testLikesJson = []byte(`
+[
+  {
+    "created_at": "2000-01-01T00:00:00+00:00",
+    "user": {
+      "description": "Hello, world.",
+      "facebook_id": "yaotti",
+      "followees_count": 100,
+      "followers_count": 200,
+      "github_login_name": "yaotti",
+      "id": "yaotti",
+      "items_count": 300,
+      "linkedin_id": "yaotti",
+      "location": "Tokyo, Japan",
+      "name": "Hiroshige Umino",
+      "organization": "Increments Inc",
+      "permanent_id": 1,
+      "profile_image_url": "https://si0.twimg.com/profile_images/2309761038/1ijg13pfs0dg84sk2y0h_normal.jpeg",
+      "twitter_screen_name": "yaotti",
+      "website_url": "http://yaotti.hatenablog.com"
+    }
+  }
+]
+`)
+
+func TestStockItem(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	stocked := false
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f/stock", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			stocked = true
+		case "DELETE":
+			stocked = false
+		case "GET":
+			if !stocked {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write(testNotFoundJson)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	for _, want := range []bool{true, false} {
+		var err error
+		if want {
+			err = client.StockItem(ctx, "4bd431809afb1bb99e4f")
+		} else {
+			err = client.UnstockItem(ctx, "4bd431809afb1bb99e4f")
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		have, err := client.IsStocked(ctx, "4bd431809afb1bb99e4f")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want != have {
+			t.Fatalf("Stocked not matched.\nwant: %v\nhave: %v\n", want, have)
+		}
+	}
+}
+
+func TestIsStockedError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f/stock", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	if _, err := client.IsStocked(context.Background(), "4bd431809afb1bb99e4f"); !IsUnauthorized(err) {
+		t.Fatalf("Unauthorized expected, but %v", err)
+	}
+}
+
+func TestListStockers(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f/stockers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(testUsersJson)
+	})
+
+	us, _, err := client.ListStockers(context.Background(), "4bd431809afb1bb99e4f", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(us) != 1 || !UserValueEqual(&testPosts[0].User, &us[0]) {
+		t.Fatalf("Response not matched: %v", us)
+	}
+}
+
+func TestListUserStocks(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/users/yaotti/stocks", testPostsJson, 2)
+
+	it := client.UserStocks("yaotti", &ListOptions{PerPage: 100})
+	n := 0
+	for it.Next(context.Background()) {
+		if !PostValueEqual(&testPosts[0], it.Item()) {
+			t.Fatalf("Item not matched.\nwant: %v\nhave: %v\n", testPosts[0], it.Item())
+		}
+		n++
+	}
+	if it.Err() != nil || n != 2 {
+		t.Fatalf("Iteration not matched: %v, %v", n, it.Err())
+	}
+}
+
+func TestListItemLikes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/items/4bd431809afb1bb99e4f/likes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(testLikesJson)
+	})
+
+	ls, err := client.ListItemLikes(context.Background(), "4bd431809afb1bb99e4f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 1 || !ls[0].CreatedAt.Equal(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)) || !UserValueEqual(&testPosts[0].User, &ls[0].User) {
+		t.Fatalf("Response not matched: %v", ls)
+	}
+}
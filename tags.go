@@ -0,0 +1,103 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/url"
+)
+
+// ListTagsOptions is options for ListTags.
+type ListTagsOptions struct {
+	ListOptions
+	// Sort is "count"(default) or "name".
+	Sort string
+}
+
+func (o *ListTagsOptions) values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+	v := o.ListOptions.values()
+	if o.Sort != "" {
+		v.Set("sort", o.Sort)
+	}
+	return v
+}
+
+// GetTag gets tag by id.
+func (c *Client) GetTag(ctx context.Context, id string) (*Tag, error) {
+	t := &Tag{}
+	if _, err := c.call(ctx, "GET", "tags/"+url.PathEscape(id), nil, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListTags lists tags.
+func (c *Client) ListTags(ctx context.Context, opts *ListTagsOptions) (Tags, *Response, error) {
+	ts := Tags{}
+	resp, err := c.call(ctx, "GET", withValues("tags", opts.values()), nil, &ts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ts, resp, nil
+}
+
+// ListAllTags lists tags of all pages.
+func (c *Client) ListAllTags(ctx context.Context, opts *ListTagsOptions) (Tags, error) {
+	o := ListTagsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	all := Tags{}
+	err := allPages(&o.ListOptions, func(lo *ListOptions) (*Response, error) {
+		o.ListOptions = *lo
+		ts, resp, err := c.ListTags(ctx, &o)
+		all = append(all, ts...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// ListTagItems lists items(posts) tagged with tag.
+func (c *Client) ListTagItems(ctx context.Context, tagId string, opts *ListOptions) (Posts, *Response, error) {
+	ps := Posts{}
+	resp, err := c.call(ctx, "GET", withValues("tags/"+url.PathEscape(tagId)+"/items", opts.values()), nil, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ps, resp, nil
+}
+
+// ListFollowingTags lists tags followed by user.
+func (c *Client) ListFollowingTags(ctx context.Context, userId string, opts *ListOptions) (Tags, *Response, error) {
+	ts := Tags{}
+	resp, err := c.call(ctx, "GET", withValues("users/"+url.PathEscape(userId)+"/following_tags", opts.values()), nil, &ts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ts, resp, nil
+}
+
+// FollowTag follows tag.
+func (c *Client) FollowTag(ctx context.Context, tagId string) error {
+	_, err := c.call(ctx, "PUT", "tags/"+url.PathEscape(tagId)+"/following", nil, nil)
+	return err
+}
+
+// UnfollowTag unfollows tag.
+func (c *Client) UnfollowTag(ctx context.Context, tagId string) error {
+	_, err := c.call(ctx, "DELETE", "tags/"+url.PathEscape(tagId)+"/following", nil, nil)
+	return err
+}
+
+// IsFollowingTag reports whether tag is followed by authenticated user.
+func (c *Client) IsFollowingTag(ctx context.Context, tagId string) (bool, error) {
+	return c.check(ctx, "tags/"+url.PathEscape(tagId)+"/following")
+}
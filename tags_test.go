@@ -0,0 +1,105 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testTagsJson = []byte(`
+[
+  {
+    "followers_count": 100,
+    "icon_url": "https://s3-ap-northeast-1.amazonaws.com/qiita-tag-image/9de6a11d330f5694820082438f88ccf4a1b289b2/medium.jpg",
+    "id": "qiita",
+    "items_count": 200
+  }
+]
+`)
+
+var iconUrl = "https://s3-ap-northeast-1.amazonaws.com/qiita-tag-image/9de6a11d330f5694820082438f88ccf4a1b289b2/medium.jpg"
+
+var testTags = Tags{
+	Tag{
+		Id:             "qiita",
+		FollowersCount: 100,
+		IconUrl:        &iconUrl,
+		ItemsCount:     200,
+	},
+}
+
+func TestGetTag(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/tags/qiita", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(firstJson(t, testTagsJson))
+	})
+
+	tag, err := client.GetTag(context.Background(), "qiita")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testTags[0], tag) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTags[0], tag)
+	}
+}
+
+func TestListTags(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if want := "page=1&per_page=20&sort=name"; r.URL.RawQuery != want {
+			t.Errorf("Query not matched.\nwant: %v\nhave: %v\n", want, r.URL.RawQuery)
+		}
+		w.Write(testTagsJson)
+	})
+
+	opts := &ListTagsOptions{ListOptions: ListOptions{Page: 1, PerPage: 20}, Sort: "name"}
+	tags, _, err := client.ListTags(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(testTags, tags) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTags, tags)
+	}
+}
+
+func TestListFollowingTags(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/users/yaotti/following_tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(testTagsJson)
+	})
+
+	tags, _, err := client.ListFollowingTags(context.Background(), "yaotti", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(testTags, tags) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTags, tags)
+	}
+}
+
+func TestFollowTag(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handleFollowing(mux, "/api/v2/tags/qiita/following")
+
+	ctx := context.Background()
+	if err := client.FollowTag(ctx, "qiita"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := client.IsFollowingTag(ctx, "qiita"); err != nil || !ok {
+		t.Fatalf("Following expected: %v, %v", ok, err)
+	}
+	if err := client.UnfollowTag(ctx, "qiita"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := client.IsFollowingTag(ctx, "qiita"); err != nil || ok {
+		t.Fatalf("Not following expected: %v, %v", ok, err)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/url"
+)
+
+// ListTeams lists teams which authenticated user belongs to.
+func (c *Client) ListTeams(ctx context.Context) (Teams, error) {
+	ts := Teams{}
+	if _, err := c.call(ctx, "GET", "teams", nil, &ts); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// Team members and invitations are available in Qiita:Team only,
+// for team administrators.
+
+// ListTeamMembers lists members of team.
+func (c *Client) ListTeamMembers(ctx context.Context, opts *ListOptions) (TeamMembers, *Response, error) {
+	ms := TeamMembers{}
+	resp, err := c.call(ctx, "GET", withValues("team_members", opts.values()), nil, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ms, resp, nil
+}
+
+// ListAllTeamMembers lists members of team of all pages.
+func (c *Client) ListAllTeamMembers(ctx context.Context, opts *ListOptions) (TeamMembers, error) {
+	all := TeamMembers{}
+	err := allPages(opts, func(o *ListOptions) (*Response, error) {
+		ms, resp, err := c.ListTeamMembers(ctx, o)
+		all = append(all, ms...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// ListTeamInvitations lists pending invitations of team.
+func (c *Client) ListTeamInvitations(ctx context.Context) (TeamInvitations, error) {
+	is := TeamInvitations{}
+	if _, err := c.call(ctx, "GET", "team_invitations", nil, &is); err != nil {
+		return nil, err
+	}
+	return is, nil
+}
+
+// InviteTeamMember sends invitation to email.
+func (c *Client) InviteTeamMember(ctx context.Context, email string) (*TeamInvitation, error) {
+	i := &TeamInvitation{}
+	if _, err := c.call(ctx, "POST", "team_invitations", &PostTeamInvitation{Email: email}, i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// RevokeTeamInvitation revokes invitation sent to email.
+func (c *Client) RevokeTeamInvitation(ctx context.Context, email string) error {
+	_, err := c.call(ctx, "DELETE", "team_invitations/"+url.PathEscape(email), nil, nil)
+	return err
+}
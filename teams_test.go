@@ -0,0 +1,113 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testTeamMembersJson = []byte(`
+[
+  {
+    "id": "yaotti",
+    "name": "Hiroshige Umino",
+    "email": "yaotti@example.com"
+  }
+]
+`)
+
+var testTeamInvitationsJson = []byte(`
+[
+  {
+    "email": "yaotti@example.com",
+    "url": "https://increments.qiita.com/registration?invitation_token=token"
+  }
+]
+`)
+
+var testTeamMembers = TeamMembers{
+	TeamMember{
+		Id:    "yaotti",
+		Name:  "Hiroshige Umino",
+		Email: "yaotti@example.com",
+	},
+}
+
+var testTeamInvitations = TeamInvitations{
+	TeamInvitation{
+		Email: "yaotti@example.com",
+		Url:   "https://increments.qiita.com/registration?invitation_token=token",
+	},
+}
+
+func TestListTeams(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte("[" + string(testTeamJson) + "]"))
+	})
+
+	ts, err := client.ListTeams(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Teams{testTeam}); !reflect.DeepEqual(want, ts) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", want, ts)
+	}
+}
+
+func TestListTeamMembers(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/team_members", testTeamMembersJson, 1)
+
+	ms, err := client.ListAllTeamMembers(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(testTeamMembers, ms) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTeamMembers, ms)
+	}
+}
+
+func TestTeamInvitations(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/team_invitations", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Write(testTeamInvitationsJson)
+		case "POST":
+			testBody(t, r, []byte(`{"email":"yaotti@example.com"}`))
+			w.WriteHeader(http.StatusCreated)
+			w.Write(firstJson(t, testTeamInvitationsJson))
+		default:
+			t.Errorf("Unexpected method: %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v2/team_invitations/yaotti@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	is, err := client.ListTeamInvitations(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(testTeamInvitations, is) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTeamInvitations, is)
+	}
+	i, err := client.InviteTeamMember(ctx, "yaotti@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testTeamInvitations[0], i) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTeamInvitations[0], i)
+	}
+	if err := client.RevokeTeamInvitation(ctx, "yaotti@example.com"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"strconv"
+)
+
+// Templates are available in Qiita:Team only.
+
+// ListTemplates lists templates.
+func (c *Client) ListTemplates(ctx context.Context, opts *ListOptions) (Templates, *Response, error) {
+	ts := Templates{}
+	resp, err := c.call(ctx, "GET", withValues("templates", opts.values()), nil, &ts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return ts, resp, nil
+}
+
+// ListAllTemplates lists templates of all pages.
+func (c *Client) ListAllTemplates(ctx context.Context, opts *ListOptions) (Templates, error) {
+	all := Templates{}
+	err := allPages(opts, func(o *ListOptions) (*Response, error) {
+		ts, resp, err := c.ListTemplates(ctx, o)
+		all = append(all, ts...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// GetTemplate gets template by id.
+func (c *Client) GetTemplate(ctx context.Context, id int) (*Template, error) {
+	t := &Template{}
+	if _, err := c.call(ctx, "GET", "templates/"+strconv.Itoa(id), nil, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// CreateTemplate creates new template.
+func (c *Client) CreateTemplate(ctx context.Context, template *PostTemplate) (*Template, error) {
+	t := &Template{}
+	if _, err := c.call(ctx, "POST", "templates", template, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// UpdateTemplate updates template by id.
+func (c *Client) UpdateTemplate(ctx context.Context, id int, template *PostTemplate) (*Template, error) {
+	t := &Template{}
+	if _, err := c.call(ctx, "PATCH", "templates/"+strconv.Itoa(id), template, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DeleteTemplate deletes template by id.
+func (c *Client) DeleteTemplate(ctx context.Context, id int) error {
+	_, err := c.call(ctx, "DELETE", "templates/"+strconv.Itoa(id), nil, nil)
+	return err
+}
+
+// expandedTemplateResult is response of POST "expanded_templates".
+type expandedTemplateResult struct {
+	ExpandedBody  string   `json:"expanded_body"`
+	ExpandedTags  Taggings `json:"expanded_tags"`
+	ExpandedTitle string   `json:"expanded_title"`
+}
+
+// ExpandTemplate expands placeholders in template on server,
+// and returns expanded one.
+func (c *Client) ExpandTemplate(ctx context.Context, template *ExpandedTemplate) (*ExpandedTemplate, error) {
+	r := &expandedTemplateResult{}
+	if _, err := c.call(ctx, "POST", "expanded_templates", template, r); err != nil {
+		return nil, err
+	}
+	return &ExpandedTemplate{
+		Body:  r.ExpandedBody,
+		Tags:  r.ExpandedTags,
+		Title: r.ExpandedTitle,
+	}, nil
+}
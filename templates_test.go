@@ -0,0 +1,132 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testExpandedTemplateResultJson = []byte(`
+{
+  "expanded_body": "Weekly MTG on 2000/01/01",
+  "expanded_tags": [
+    {
+      "name": "MTG/2000/01/01",
+      "versions": [
+        "0.0.1"
+      ]
+    }
+  ],
+  "expanded_title": "Weekly MTG on 2000/01/01"
+}
+`)
+
+func TestListTemplates(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handlePages(t, mux, "/api/v2/templates", testTemplatesJson, 2)
+
+	ts, err := client.ListAllTemplates(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(Templates{}, testTemplates[0], testTemplates[0])
+	if !reflect.DeepEqual(want, ts) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", want, ts)
+	}
+}
+
+func TestGetTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/templates/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(firstJson(t, testTemplatesJson))
+	})
+
+	tmpl, err := client.GetTemplate(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testTemplates[0], tmpl) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTemplates[0], tmpl)
+	}
+}
+
+func TestCreateTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, testPostTemplateJson)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(firstJson(t, testTemplatesJson))
+	})
+
+	tmpl, err := client.CreateTemplate(context.Background(), &testPostTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testTemplates[0], tmpl) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTemplates[0], tmpl)
+	}
+}
+
+func TestUpdateTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/templates/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PATCH":
+			testBody(t, r, testPostTemplateJson)
+			w.Write(firstJson(t, testTemplatesJson))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method: %v", r.Method)
+		}
+	})
+
+	tmpl, err := client.UpdateTemplate(context.Background(), 1, &testPostTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&testTemplates[0], tmpl) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testTemplates[0], tmpl)
+	}
+	if err := client.DeleteTemplate(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExpandTemplate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/expanded_templates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, testExpandedTemplateJson)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(testExpandedTemplateResultJson)
+	})
+
+	et, err := client.ExpandTemplate(context.Background(), &testExpandedTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ExpandedTemplate{
+		Body: "Weekly MTG on 2000/01/01",
+		Tags: Taggings{
+			Tagging{
+				Name: "MTG/2000/01/01",
+				Versions: []string{
+					"0.0.1",
+				},
+			},
+		},
+		Title: "Weekly MTG on 2000/01/01",
+	}
+	if !reflect.DeepEqual(want, et) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", want, et)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// ErrNoToken is returned by TokenSource when token is not found.
+var ErrNoToken = errors.New("qiitago: access token not found")
+
+// TokenSource supplies access token for each request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is adapter to use function as TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f.
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns TokenSource of fixed token.
+func StaticTokenSource(token string) TokenSource {
+	return TokenSourceFunc(func(ctx context.Context) (string, error) {
+		if token == "" {
+			return "", ErrNoToken
+		}
+		return token, nil
+	})
+}
+
+// EnvTokenName is environment variable of access token.
+const EnvTokenName = "QIITA_ACCESS_TOKEN"
+
+// EnvTokenSource returns TokenSource reading QIITA_ACCESS_TOKEN.
+func EnvTokenSource() TokenSource {
+	return TokenSourceFunc(func(ctx context.Context) (string, error) {
+		if token := os.Getenv(EnvTokenName); token != "" {
+			return token, nil
+		}
+		return "", ErrNoToken
+	})
+}
+
+// TokenConfig is struct for config file of access tokens.
+//
+//	{
+//	  "access_token": "token for public Qiita",
+//	  "teams": {
+//	    "increments": "token for Qiita:Team"
+//	  }
+//	}
+type TokenConfig struct {
+	AccessToken string            `json:"access_token"`
+	Teams       map[string]string `json:"teams"`
+}
+
+// userConfigDir returns user config directory, %AppData% on Windows,
+// "~/Library/Application Support" on macOS, and $XDG_CONFIG_HOME
+// or "~/.config" on others.
+func userConfigDir() (string, error) {
+	switch runtime.GOOS {
+	case "windows":
+		if dir := os.Getenv("AppData"); dir != "" {
+			return dir, nil
+		}
+		return "", errors.New("qiitago: %AppData% is not defined")
+	case "darwin":
+		if home := os.Getenv("HOME"); home != "" {
+			return filepath.Join(home, "Library", "Application Support"), nil
+		}
+	default:
+		if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+			return dir, nil
+		}
+		if home := os.Getenv("HOME"); home != "" {
+			return filepath.Join(home, ".config"), nil
+		}
+	}
+	return "", errors.New("qiitago: $HOME is not defined")
+}
+
+// DefaultTokenConfigPath returns path of config file,
+// "qiitago/config.json" in user config directory.
+func DefaultTokenConfigPath() (string, error) {
+	dir, err := userConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "qiitago", "config.json"), nil
+}
+
+// ConfigFileTokenSource returns TokenSource reading config file at path,
+// DefaultTokenConfigPath when path is empty. Token of team is read
+// when teamId is not empty, else token for public Qiita.
+func ConfigFileTokenSource(path string, teamId string) TokenSource {
+	return TokenSourceFunc(func(ctx context.Context) (string, error) {
+		p := path
+		if p == "" {
+			var err error
+			if p, err = DefaultTokenConfigPath(); err != nil {
+				return "", err
+			}
+		}
+		b, err := ioutil.ReadFile(p)
+		if os.IsNotExist(err) {
+			return "", ErrNoToken
+		}
+		if err != nil {
+			return "", err
+		}
+		conf := TokenConfig{}
+		if err := json.Unmarshal(b, &conf); err != nil {
+			return "", err
+		}
+		token := conf.AccessToken
+		if teamId != "" {
+			token = conf.Teams[teamId]
+		}
+		if token == "" {
+			return "", ErrNoToken
+		}
+		return token, nil
+	})
+}
+
+// ChainTokenSource returns TokenSource trying srcs in order,
+// skipping ones returning ErrNoToken.
+func ChainTokenSource(srcs ...TokenSource) TokenSource {
+	return TokenSourceFunc(func(ctx context.Context) (string, error) {
+		for _, src := range srcs {
+			token, err := src.Token(ctx)
+			if err == ErrNoToken {
+				continue
+			}
+			return token, err
+		}
+		return "", ErrNoToken
+	})
+}
+
+// DefaultTokenSource returns TokenSource discovering token from
+// QIITA_ACCESS_TOKEN, then default config file.
+// Config file is only used for team when teamId is not empty.
+func DefaultTokenSource(teamId string) TokenSource {
+	if teamId != "" {
+		return ConfigFileTokenSource("", teamId)
+	}
+	return ChainTokenSource(EnvTokenSource(), ConfigFileTokenSource("", ""))
+}
+
+// refreshingTokenSource caches token of src for ttl.
+type refreshingTokenSource struct {
+	src TokenSource
+	ttl time.Duration
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// RefreshingTokenSource returns TokenSource caching token of src,
+// and fetching it again after ttl.
+func RefreshingTokenSource(src TokenSource, ttl time.Duration) TokenSource {
+	return &refreshingTokenSource{src: src, ttl: ttl}
+}
+
+func (r *refreshingTokenSource) Token(ctx context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.token != "" && timeNow().Before(r.expires) {
+		return r.token, nil
+	}
+	token, err := r.src.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	r.token, r.expires = token, timeNow().Add(r.ttl)
+	return token, nil
+}
@@ -0,0 +1,152 @@
+package qiitago
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+var testTokenConfigJson = []byte(`
+{
+  "access_token": "public",
+  "teams": {
+    "increments": "team"
+  }
+}
+`)
+
+func writeTokenConfig(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "qiitago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(path, testTokenConfigJson, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func TestTokenSources(t *testing.T) {
+	path, cleanup := writeTokenConfig(t)
+	defer cleanup()
+	defer os.Setenv(EnvTokenName, os.Getenv(EnvTokenName))
+	os.Setenv(EnvTokenName, "env")
+
+	tests := []struct {
+		src  TokenSource
+		want string
+		err  error
+	}{
+		{StaticTokenSource("static"), "static", nil},
+		{StaticTokenSource(""), "", ErrNoToken},
+		{EnvTokenSource(), "env", nil},
+		{ConfigFileTokenSource(path, ""), "public", nil},
+		{ConfigFileTokenSource(path, "increments"), "team", nil},
+		{ConfigFileTokenSource(path, "none"), "", ErrNoToken},
+		{ConfigFileTokenSource(path+".none", ""), "", ErrNoToken},
+		{ChainTokenSource(StaticTokenSource(""), ConfigFileTokenSource(path, "")), "public", nil},
+		{ChainTokenSource(StaticTokenSource("")), "", ErrNoToken},
+	}
+	for _, tt := range tests {
+		token, err := tt.src.Token(context.Background())
+		if token != tt.want || err != tt.err {
+			t.Errorf("Token not matched.\nwant: %v, %v\nhave: %v, %v\n", tt.want, tt.err, token, err)
+		}
+	}
+}
+
+func TestRefreshingTokenSource(t *testing.T) {
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	origNow := timeNow
+	defer func() { timeNow = origNow }()
+	timeNow = func() time.Time { return now }
+
+	n := 0
+	src := RefreshingTokenSource(TokenSourceFunc(func(ctx context.Context) (string, error) {
+		n++
+		return "token", nil
+	}), time.Minute)
+	for _, d := range []time.Duration{0, 30 * time.Second, 2 * time.Minute} {
+		now = now.Add(d)
+		if token, err := src.Token(context.Background()); token != "token" || err != nil {
+			t.Fatalf("Token not matched: %v, %v", token, err)
+		}
+	}
+	if n != 2 {
+		t.Fatalf("Fetch count not matched.\nwant: %v\nhave: %v\n", 2, n)
+	}
+}
+
+func TestClientTokenSource(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	want := "Bearer source"
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != want {
+			t.Errorf("Authorization not matched.\nwant: %v\nhave: %v\n", want, r.Header.Get("Authorization"))
+		}
+		w.Write(testPostsJson)
+	})
+
+	ctx := context.Background()
+	client.TokenSource = StaticTokenSource("source")
+	if _, _, err := client.ListItems(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	want = ""
+	client.TokenSource = StaticTokenSource("")
+	if _, _, err := client.ListItems(ctx, nil); err != nil {
+		t.Fatalf("Unauthenticated request expected, but %v", err)
+	}
+	errToken := errors.New("broken config")
+	client.TokenSource = TokenSourceFunc(func(ctx context.Context) (string, error) {
+		return "", errToken
+	})
+	if _, _, err := client.ListItems(ctx, nil); err != errToken {
+		t.Fatalf("Token error expected, but %v", err)
+	}
+}
+
+func TestClientSetTeamTokenSource(t *testing.T) {
+	path, cleanup := writeTokenConfig(t)
+	defer cleanup()
+
+	s := NewClientSet("public", nil)
+	s.TeamTokenSource = func(teamId string) TokenSource {
+		return ConfigFileTokenSource(path, teamId)
+	}
+	team, err := s.Team("increments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := team.NewRequest(context.Background(), "GET", "items", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Bearer team"; req.Header.Get("Authorization") != want {
+		t.Fatalf("Authorization not matched.\nwant: %v\nhave: %v\n", want, req.Header.Get("Authorization"))
+	}
+}
+
+func TestDefaultTokenConfigPath(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "darwin" {
+		t.Skip("XDG_CONFIG_HOME is used on others only.")
+	}
+	defer os.Setenv("XDG_CONFIG_HOME", os.Getenv("XDG_CONFIG_HOME"))
+	os.Setenv("XDG_CONFIG_HOME", "/tmp/config")
+
+	path, err := DefaultTokenConfigPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join("/tmp/config", "qiitago", "config.json"); path != want {
+		t.Fatalf("Path not matched.\nwant: %v\nhave: %v\n", want, path)
+	}
+}
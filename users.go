@@ -0,0 +1,80 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"context"
+	"net/url"
+)
+
+// GetUser gets user by id.
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	u := &User{}
+	if _, err := c.call(ctx, "GET", "users/"+url.PathEscape(id), nil, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// ListUsers lists users in descending order of created time.
+func (c *Client) ListUsers(ctx context.Context, opts *ListOptions) (Users, *Response, error) {
+	us := Users{}
+	resp, err := c.call(ctx, "GET", withValues("users", opts.values()), nil, &us)
+	if err != nil {
+		return nil, resp, err
+	}
+	return us, resp, nil
+}
+
+// ListAllUsers lists users of all pages.
+func (c *Client) ListAllUsers(ctx context.Context, opts *ListOptions) (Users, error) {
+	all := Users{}
+	err := allPages(opts, func(o *ListOptions) (*Response, error) {
+		us, resp, err := c.ListUsers(ctx, o)
+		all = append(all, us...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// ListFollowees lists users followed by user.
+func (c *Client) ListFollowees(ctx context.Context, userId string, opts *ListOptions) (Users, *Response, error) {
+	us := Users{}
+	resp, err := c.call(ctx, "GET", withValues("users/"+url.PathEscape(userId)+"/followees", opts.values()), nil, &us)
+	if err != nil {
+		return nil, resp, err
+	}
+	return us, resp, nil
+}
+
+// ListFollowers lists users following user.
+func (c *Client) ListFollowers(ctx context.Context, userId string, opts *ListOptions) (Users, *Response, error) {
+	us := Users{}
+	resp, err := c.call(ctx, "GET", withValues("users/"+url.PathEscape(userId)+"/followers", opts.values()), nil, &us)
+	if err != nil {
+		return nil, resp, err
+	}
+	return us, resp, nil
+}
+
+// FollowUser follows user.
+func (c *Client) FollowUser(ctx context.Context, userId string) error {
+	_, err := c.call(ctx, "PUT", "users/"+url.PathEscape(userId)+"/following", nil, nil)
+	return err
+}
+
+// UnfollowUser unfollows user.
+func (c *Client) UnfollowUser(ctx context.Context, userId string) error {
+	_, err := c.call(ctx, "DELETE", "users/"+url.PathEscape(userId)+"/following", nil, nil)
+	return err
+}
+
+// IsFollowingUser reports whether user is followed by authenticated user.
+func (c *Client) IsFollowingUser(ctx context.Context, userId string) (bool, error) {
+	return c.check(ctx, "users/"+url.PathEscape(userId)+"/following")
+}
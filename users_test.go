@@ -0,0 +1,90 @@
+package qiitago
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// handleFollowing serves PUT, DELETE and GET of following api.
+func handleFollowing(mux *http.ServeMux, path string) {
+	following := false
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			following = true
+		case "DELETE":
+			following = false
+		case "GET":
+			if !following {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write(testNotFoundJson)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func TestGetUser(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/users/yaotti", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(firstJson(t, testUsersJson))
+	})
+
+	u, err := client.GetUser(context.Background(), "yaotti")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !UserValueEqual(&testPosts[0].User, u) {
+		t.Fatalf("Response not matched.\nwant: %v\nhave: %v\n", testPosts[0].User, u)
+	}
+}
+
+func TestListFollowees(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/api/v2/users/yaotti/followees", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(testUsersJson)
+	})
+	mux.HandleFunc("/api/v2/users/yaotti/followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write(testUsersJson)
+	})
+
+	for _, list := range []func(context.Context, string, *ListOptions) (Users, *Response, error){
+		client.ListFollowees,
+		client.ListFollowers,
+	} {
+		us, _, err := list(context.Background(), "yaotti", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(us) != 1 || !UserValueEqual(&testPosts[0].User, &us[0]) {
+			t.Fatalf("Response not matched: %v", us)
+		}
+	}
+}
+
+func TestFollowUser(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	handleFollowing(mux, "/api/v2/users/yaotti/following")
+
+	ctx := context.Background()
+	if err := client.FollowUser(ctx, "yaotti"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := client.IsFollowingUser(ctx, "yaotti"); err != nil || !ok {
+		t.Fatalf("Following expected: %v, %v", ok, err)
+	}
+	if err := client.UnfollowUser(ctx, "yaotti"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := client.IsFollowingUser(ctx, "yaotti"); err != nil || ok {
+		t.Fatalf("Not following expected: %v, %v", ok, err)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2018 Yutaka Nishimura. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package qiitago
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Limits of tags in Qiita api.
+const (
+	MinTags        = 1
+	MaxTags        = 5
+	MaxTagVersions = 3
+)
+
+// ValidationError is error of Validate, listing every problem.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "qiitago: invalid payload: " + strings.Join(e.Problems, "; ")
+}
+
+// validator collects problems.
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) required(name, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.addf("%s is empty", name)
+	}
+}
+
+// tags checks number of tags within min and MaxTags, and each tag.
+func (v *validator) tags(tags Taggings, min int) {
+	if len(tags) < min || len(tags) > MaxTags {
+		v.addf("tags must be %d to %d, but %d", min, MaxTags, len(tags))
+	}
+	seen := map[string]bool{}
+	for i, t := range tags {
+		if t.Name == "" {
+			v.addf("tags[%d].name is empty", i)
+		}
+		for _, r := range t.Name {
+			if unicode.IsSpace(r) || unicode.IsControl(r) || r == ',' {
+				v.addf("tags[%d].name %q has invalid character %q", i, t.Name, r)
+				break
+			}
+		}
+		if key := strings.ToLower(t.Name); t.Name != "" && seen[key] {
+			v.addf("tags[%d].name %q is duplicated", i, t.Name)
+		} else {
+			seen[key] = true
+		}
+		if len(t.Versions) > MaxTagVersions {
+			v.addf("tags[%d].versions must be at most %d, but %d", i, MaxTagVersions, len(t.Versions))
+		}
+		for j, ver := range t.Versions {
+			if strings.TrimSpace(ver) == "" {
+				v.addf("tags[%d].versions[%d] is empty", i, j)
+			}
+		}
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: v.problems}
+}
+
+// Validate checks item before sending, returns *ValidationError.
+func (p *PostItem) Validate() error {
+	v := &validator{}
+	v.required("title", p.Title)
+	v.required("body", p.Body)
+	v.tags(p.Tags, MinTags)
+	if p.GroupUrlName != nil {
+		v.required("group_url_name", *p.GroupUrlName)
+	}
+	return v.err()
+}
+
+// Validate checks set fields of item before sending, returns *ValidationError.
+func (p *PatchItem) Validate() error {
+	v := &validator{}
+	if p.Title != nil {
+		v.required("title", *p.Title)
+	}
+	if p.Body != nil {
+		v.required("body", *p.Body)
+	}
+	// Empty tags are omitted from request, so left unchanged.
+	if len(p.Tags) > 0 {
+		v.tags(p.Tags, MinTags)
+	}
+	if p.GroupUrlName != nil {
+		v.required("group_url_name", *p.GroupUrlName)
+	}
+	return v.err()
+}
+
+// Validate checks template before sending, returns *ValidationError.
+func (t *PostTemplate) Validate() error {
+	v := &validator{}
+	v.required("name", t.Name)
+	v.required("title", t.Title)
+	v.required("body", t.Body)
+	v.tags(t.Tags, MinTags)
+	return v.err()
+}
+
+// Validate checks project before sending, returns *ValidationError.
+// Tags are optional for project.
+func (p *PostProject) Validate() error {
+	v := &validator{}
+	v.required("name", p.Name)
+	v.required("body", p.Body)
+	v.tags(p.Tags, 0)
+	return v.err()
+}
+
+// Validate checks comment before sending, returns *ValidationError.
+func (c *PostComment) Validate() error {
+	v := &validator{}
+	v.required("body", c.Body)
+	return v.err()
+}
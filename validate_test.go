@@ -0,0 +1,89 @@
+package qiitago
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, p := range []interface {
+		Validate() error
+	}{
+		&testPostItem,
+		&PatchItem{Title: String("Example title"), Body: String("# Example"), Tags: testPostItem.Tags},
+		&PatchItem{Private: Bool(true)},
+		&testPostTemplate,
+		&testPostProject,
+		&PostProject{Name: "Kobiro Project", Body: "# Example"},
+		&PostComment{Body: "# Example"},
+	} {
+		if err := p.Validate(); err != nil {
+			t.Errorf("Valid expected: %v", err)
+		}
+	}
+}
+
+func TestValidateProblems(t *testing.T) {
+	empty := ""
+	item := &PostItem{
+		Title:        " ",
+		GroupUrlName: &empty,
+		Tags: Taggings{
+			Tagging{Name: "Visual Studio"},
+			Tagging{Name: "go"},
+			Tagging{Name: "Go", Versions: []string{"1.8", "1.9", "1.10", ""}},
+			Tagging{},
+			Tagging{Name: "a,b"},
+			Tagging{Name: "Ruby"},
+		},
+	}
+	err := item.Validate()
+	e, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("ValidationError expected, but %v", err)
+	}
+	want := []string{
+		"title is empty",
+		"body is empty",
+		"tags must be 1 to 5, but 6",
+		`tags[0].name "Visual Studio" has invalid character ' '`,
+		`tags[2].name "Go" is duplicated`,
+		"tags[2].versions must be at most 3, but 4",
+		"tags[2].versions[3] is empty",
+		"tags[3].name is empty",
+		`tags[4].name "a,b" has invalid character ','`,
+		"group_url_name is empty",
+	}
+	if !reflect.DeepEqual(want, e.Problems) {
+		t.Fatalf("Problems not matched.\nwant: %q\nhave: %q\n", want, e.Problems)
+	}
+}
+
+func TestValidateMissingTags(t *testing.T) {
+	for _, p := range []interface {
+		Validate() error
+	}{
+		&PostItem{Title: "Example title", Body: "# Example"},
+		&PostTemplate{Name: "Weekly MTG", Title: "Weekly MTG", Body: "# Example"},
+	} {
+		e, ok := p.Validate().(*ValidationError)
+		if !ok || len(e.Problems) != 1 || e.Problems[0] != "tags must be 1 to 5, but 0" {
+			t.Errorf("Missing tags expected: %v", e)
+		}
+	}
+}
+
+func TestValidateEmptyPatchTags(t *testing.T) {
+	p := &PatchItem{Title: String("Example title"), Tags: Taggings{}}
+	if err := p.Validate(); err != nil {
+		t.Fatalf("Empty tags expected valid: %v", err)
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"title":"Example title"}`; string(b) != want {
+		t.Fatalf("Json not matched.\nwant: %v\nhave: %v\n", want, string(b))
+	}
+}